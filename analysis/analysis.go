@@ -0,0 +1,249 @@
+// Package analysis judges moves against the best play the engines can find:
+// the solver where it can search to the end of the game, and Monte Carlo tree
+// search where it cannot.
+package analysis
+
+import (
+	"context"
+	"main/mcts"
+	"main/rules"
+	"main/solver"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Label grades a move against the best move of its position
+type Label string
+
+const (
+	// Best keeps the best result on offer
+	Best Label = "best"
+	// Inaccuracy keeps the result but plays it worse, e.g. a slower win
+	Inaccuracy Label = "inaccuracy"
+	// Blunder gives away a better result
+	Blunder Label = "blunder"
+)
+
+// Score margins on estimated moves: a move that scores more than
+// inaccuracyMargin below the best is an inaccuracy, and one more than
+// blunderMargin below it a blunder
+const (
+	inaccuracyMargin = 0.1
+	blunderMargin    = 0.3
+)
+
+// MoveEvaluation is how a move is expected to end for the side playing it
+type MoveEvaluation struct {
+	Move int
+	// Score runs from -1 for a loss through 0 for a draw to 1 for a win
+	Score float64
+	// Plies is, for an exact win or loss, how many plies it takes to end the
+	// game, and 0 otherwise
+	Plies int
+}
+
+// Evaluation is the judgement of the moves of a position
+type Evaluation struct {
+	// Moves holds the moves judged, best first. Estimates may leave out moves
+	// the search found not worth trying.
+	Moves []MoveEvaluation
+	// Exact reports whether the game was searched to the end, so that the
+	// scores are the true values of the moves rather than estimates
+	Exact bool
+}
+
+// Best returns the best move
+func (e Evaluation) Best() MoveEvaluation {
+	return e.Moves[0]
+}
+
+// Of returns the evaluation of move, if it was judged
+func (e Evaluation) Of(move int) (MoveEvaluation, bool) {
+	for _, evaluation := range e.Moves {
+		if evaluation.Move == move {
+			return evaluation, true
+		}
+	}
+	return MoveEvaluation{}, false
+}
+
+// Options limit the search of each position
+type Options struct {
+	// TimeBudget is how long to think about a position; the solver gets half
+	// of it, and if it cannot finish, Monte Carlo tree search the rest
+	TimeBudget time.Duration
+	// Seed makes Monte Carlo tree search repeatable
+	Seed uint64
+}
+
+// Evaluate judges the moves of position, which must not be over. Once ctx is
+// done it returns the little it has found so far.
+func Evaluate(ctx context.Context, position rules.Position, options Options) Evaluation {
+	result := solver.Search(ctx, position, solver.Options{TimeBudget: options.TimeBudget / 2})
+	if result.Exact {
+		moves := make([]MoveEvaluation, len(result.Moves))
+		for i, move := range result.Moves {
+			moves[i] = exactEvaluation(move.Move, move.Score)
+		}
+		return Evaluation{Moves: moves, Exact: true}
+	}
+
+	estimate := mcts.Search(ctx, position, mcts.Options{TimeBudget: options.TimeBudget / 2, Seed: options.Seed})
+	moves := make([]MoveEvaluation, len(estimate.Moves))
+	for i, move := range estimate.Moves {
+		moves[i] = MoveEvaluation{Move: move.Move, Score: 2*move.Value - 1}
+	}
+	// Moves stay in order of visits: the most visited move is the one the
+	// search trusts, even if a rarely tried move happens to average higher
+	return Evaluation{Moves: moves}
+}
+
+// exactEvaluation converts a solver score of a solved position
+func exactEvaluation(move int, score int) MoveEvaluation {
+	switch {
+	case solver.IsWin(score):
+		return MoveEvaluation{Move: move, Score: 1, Plies: solver.Win - score}
+	case solver.IsLoss(score):
+		return MoveEvaluation{Move: move, Score: -1, Plies: solver.Win + score}
+	}
+	return MoveEvaluation{Move: move}
+}
+
+// Judge labels played against the best move of a position. Exact
+// evaluations only call a move a blunder if it changes the result.
+func Judge(best MoveEvaluation, played MoveEvaluation, exact bool) Label {
+	if exact {
+		switch {
+		case played.Score < best.Score:
+			return Blunder
+		case played.Score > 0 && played.Plies > best.Plies,
+			played.Score < 0 && played.Plies < best.Plies:
+			return Inaccuracy
+		}
+		return Best
+	}
+
+	switch loss := best.Score - played.Score; {
+	case played.Move == best.Move || loss <= inaccuracyMargin:
+		return Best
+	case loss <= blunderMargin:
+		return Inaccuracy
+	}
+	return Blunder
+}
+
+// Ply is the judgement of one move of a game
+type Ply struct {
+	// Played is the move made and what it was worth
+	Played MoveEvaluation
+	// Best is the best move of the position it was made in
+	Best MoveEvaluation
+	// Label grades Played against Best
+	Label Label
+	// Exact reports whether the evaluations are exact
+	Exact bool
+}
+
+// Game is the judgement of every move of a game
+type Game struct {
+	Plies []Ply
+	// DecisivePly numbers, from 1, the ply after which the result was no
+	// longer in doubt: the last blunder, or 0 if no move gave anything away
+	DecisivePly int
+}
+
+// Analyze judges every move of a game played from the start position of
+// settings, searching the positions in parallel. It fails if a move is
+// illegal, or if ctx is done before every position has been searched.
+func Analyze(ctx context.Context, settings rules.Settings, moves []int, options Options) (Game, error) {
+	positions := make([]rules.Position, len(moves)+1)
+	positions[0] = rules.NewPosition(settings)
+	for i, move := range moves {
+		next, err := positions[i].Play(move)
+		if err != nil {
+			return Game{}, err
+		}
+		positions[i+1] = next
+	}
+
+	// Every position that is not over is searched, including the last one of
+	// a game that ended early
+	evaluations := make([]Evaluation, len(positions))
+	var wg sync.WaitGroup
+	work := make(chan int)
+	for range runtime.GOMAXPROCS(0) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				seeded := options
+				seeded.Seed += uint64(i)
+				evaluations[i] = Evaluate(ctx, positions[i], seeded)
+			}
+		}()
+	}
+dispatch:
+	for i, position := range positions {
+		if position.Outcome().Over() {
+			continue
+		}
+		select {
+		case work <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(work)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return Game{}, err
+	}
+
+	game := Game{Plies: make([]Ply, len(moves))}
+	for i, move := range moves {
+		ply := Ply{Best: evaluations[i].Best(), Exact: evaluations[i].Exact}
+
+		played, ok := evaluations[i].Of(move)
+		if !ok {
+			// Only estimates leave out moves, so judge the move by what followed
+			played = followingEvaluation(positions[i], positions[i+1], evaluations, i+1)
+			played.Move = move
+		}
+
+		ply.Played = played
+		ply.Label = Judge(ply.Best, ply.Played, ply.Exact)
+		if ply.Label == Blunder {
+			game.DecisivePly = i + 1
+		}
+		game.Plies[i] = ply
+	}
+	return game, nil
+}
+
+// followingEvaluation returns what the move from position to next is worth
+// to the side that played it, found from the outcome of next or otherwise
+// from its best move, evaluations[index]
+func followingEvaluation(position rules.Position, next rules.Position, evaluations []Evaluation, index int) MoveEvaluation {
+	mover := position.ToMove()
+	if outcome := next.Outcome(); outcome.Over() {
+		switch outcome.Winner() {
+		case rules.Empty:
+			return MoveEvaluation{}
+		case mover:
+			return MoveEvaluation{Score: 1, Plies: 1}
+		}
+		return MoveEvaluation{Score: -1, Plies: 1}
+	}
+
+	reply := evaluations[index].Best()
+	evaluation := MoveEvaluation{Score: reply.Score}
+	if reply.Plies > 0 {
+		evaluation.Plies = reply.Plies + 1
+	}
+	// Some variants let a side move twice
+	if next.ToMove() != mover {
+		evaluation.Score = -evaluation.Score
+	}
+	return evaluation
+}
@@ -0,0 +1,114 @@
+// Package bot chooses moves for computer opponents. Bots search the game tree
+// with the solver, or sample it with Monte Carlo tree search, so they play
+// every variant, and differ in how they look ahead.
+package bot
+
+import (
+	"context"
+	"main/mcts"
+	"main/rules"
+	"main/solver"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// Level is a bot's difficulty
+type Level string
+
+// Difficulty levels, the solver-based ones from weakest to strongest
+const (
+	// Easy plays random legal moves
+	Easy Level = "easy"
+	// Medium takes a win and blocks an immediate loss
+	Medium Level = "medium"
+	// Hard looks four moves ahead
+	Hard Level = "hard"
+	// Perfect searches to the end of the game when the board is small enough,
+	// and otherwise as deep as it can within its thinking time
+	Perfect Level = "perfect"
+	// MCTS plays Monte Carlo tree search for its thinking time; it is weaker
+	// than Perfect on small boards but much stronger on large ones
+	MCTS Level = "mcts"
+)
+
+// Levels lists the difficulty levels
+var Levels = []Level{Easy, Medium, Hard, Perfect, MCTS}
+
+// UsernamePrefix starts the username of the account a bot plays under, so it
+// is reserved for bots
+const UsernamePrefix = "bot_"
+
+// Username returns the account a bot of level plays under
+func Username(level Level) string {
+	return UsernamePrefix + string(level)
+}
+
+// LevelOf returns the level of the bot playing under username, if it is one
+func LevelOf(username string) (Level, bool) {
+	name, found := strings.CutPrefix(username, UsernamePrefix)
+	if !found {
+		return "", false
+	}
+	level := Level(name)
+	return level, level.Valid()
+}
+
+// Valid reports whether l is a known difficulty level
+func (l Level) Valid() bool {
+	for _, level := range Levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// thinkingTime bounds the search of every level
+const thinkingTime = time.Second
+
+// options returns how deep a level searches, or false for no search
+func (l Level) options() (solver.Options, bool) {
+	switch l {
+	case Medium:
+		return solver.Options{MaxDepth: 2, TimeBudget: thinkingTime}, true
+	case Hard:
+		return solver.Options{MaxDepth: 4, TimeBudget: thinkingTime}, true
+	case Perfect:
+		return solver.Options{TimeBudget: thinkingTime}, true
+	}
+	return solver.Options{}, false
+}
+
+// Choose returns the move a bot of level plays in position, which must not be
+// over. Moves that score the same are picked between at random.
+func Choose(position rules.Position, level Level, rng *rand.Rand) int {
+	if level == MCTS {
+		return mcts.Search(context.Background(), position, mcts.Options{TimeBudget: thinkingTime, Seed: rng.Uint64()}).Best
+	}
+
+	options, search := level.options()
+	if !search {
+		moves := position.LegalMoves()
+		return moves[rng.IntN(len(moves))]
+	}
+
+	result := solver.Search(context.Background(), position, options)
+	var best []int
+	for _, move := range result.Moves {
+		if move.Score == result.Score {
+			best = append(best, move.Move)
+		}
+	}
+	return best[rng.IntN(len(best))]
+}
+
+// AcceptsDraw reports whether a bot playing symbol agrees to a draw in
+// position, which it does unless its search finds a forced win
+func AcceptsDraw(position rules.Position, symbol string) bool {
+	score := solver.Search(context.Background(), position, solver.Options{TimeBudget: thinkingTime}).Score
+	if position.ToMove() != symbol {
+		score = -score
+	}
+	return !solver.IsWin(score)
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS game_participants_game_id_idx;
+
+ALTER TABLE "games" DROP COLUMN IF EXISTS "code";
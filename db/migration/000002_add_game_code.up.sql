@@ -0,0 +1,3 @@
+ALTER TABLE "games" ADD COLUMN "code" varchar NOT NULL UNIQUE;
+
+CREATE INDEX ON "game_participants" ("game_id");
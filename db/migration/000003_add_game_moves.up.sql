@@ -0,0 +1,14 @@
+CREATE TABLE "game_moves" (
+    "id" bigserial PRIMARY KEY,
+    "game_id" bigint NOT NULL,
+    "move_number" int NOT NULL,
+    "user_id" bigint NOT NULL,
+    "position" int NOT NULL,
+    "symbol" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "game_moves" ADD FOREIGN KEY ("game_id") REFERENCES "games" ("id");
+ALTER TABLE "game_moves" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE UNIQUE INDEX ON "game_moves" ("game_id", "move_number");
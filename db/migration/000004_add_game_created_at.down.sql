@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS games_status_created_at_idx;
+
+ALTER TABLE "games" DROP COLUMN IF EXISTS "created_at";
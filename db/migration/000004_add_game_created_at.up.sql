@@ -0,0 +1,3 @@
+ALTER TABLE "games" ADD COLUMN "created_at" timestamptz NOT NULL DEFAULT (now());
+
+CREATE INDEX ON "games" ("status", "created_at");
@@ -0,0 +1 @@
+ALTER TABLE "users" ADD COLUMN "rating" double precision NOT NULL DEFAULT 1500;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS rating_history;
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "rating_volatility";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "rating_deviation";
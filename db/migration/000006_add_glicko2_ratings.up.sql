@@ -0,0 +1,21 @@
+ALTER TABLE "users" ADD COLUMN "rating_deviation" double precision NOT NULL DEFAULT 350;
+ALTER TABLE "users" ADD COLUMN "rating_volatility" double precision NOT NULL DEFAULT 0.06;
+
+CREATE TABLE "rating_history" (
+    "id" bigserial PRIMARY KEY,
+    "user_id" bigint NOT NULL,
+    "game_id" bigint NOT NULL,
+    "result" varchar NOT NULL,
+    "rating_before" double precision NOT NULL,
+    "rating_after" double precision NOT NULL,
+    "rating_deviation_before" double precision NOT NULL,
+    "rating_deviation_after" double precision NOT NULL,
+    "rating_volatility_after" double precision NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "rating_history" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+ALTER TABLE "rating_history" ADD FOREIGN KEY ("game_id") REFERENCES "games" ("id");
+
+CREATE UNIQUE INDEX ON "rating_history" ("user_id", "game_id");
+CREATE INDEX ON "rating_history" ("created_at");
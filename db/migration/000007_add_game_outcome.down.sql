@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS game_participants_user_id_idx;
+
+ALTER TABLE "games" DROP COLUMN IF EXISTS "finished_at";
+ALTER TABLE "games" DROP COLUMN IF EXISTS "winner_user_id";
@@ -0,0 +1,19 @@
+ALTER TABLE "games" ADD COLUMN "winner_user_id" bigint;
+ALTER TABLE "games" ADD COLUMN "finished_at" timestamptz;
+
+ALTER TABLE "games" ADD FOREIGN KEY ("winner_user_id") REFERENCES "users" ("id");
+
+CREATE INDEX ON "game_participants" ("user_id");
+
+-- Backfill outcomes of games finished before they were stored on the game
+UPDATE "games" g
+SET "winner_user_id" = rh."user_id"
+FROM "rating_history" rh
+WHERE rh."game_id" = g."id" AND rh."result" = 'win';
+
+UPDATE "games" g
+SET "finished_at" = COALESCE(
+    (SELECT max(rh."created_at") FROM "rating_history" rh WHERE rh."game_id" = g."id"),
+    g."created_at"
+)
+WHERE g."status" = 'completed';
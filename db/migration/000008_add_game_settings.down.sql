@@ -0,0 +1,3 @@
+ALTER TABLE "games" DROP COLUMN IF EXISTS "win_length";
+ALTER TABLE "games" DROP COLUMN IF EXISTS "height";
+ALTER TABLE "games" DROP COLUMN IF EXISTS "width";
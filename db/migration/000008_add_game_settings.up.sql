@@ -0,0 +1,3 @@
+ALTER TABLE "games" ADD COLUMN "width" int NOT NULL DEFAULT 3;
+ALTER TABLE "games" ADD COLUMN "height" int NOT NULL DEFAULT 3;
+ALTER TABLE "games" ADD COLUMN "win_length" int NOT NULL DEFAULT 3;
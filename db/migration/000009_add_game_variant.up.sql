@@ -0,0 +1 @@
+ALTER TABLE "games" ADD COLUMN "variant" varchar NOT NULL DEFAULT 'standard';
@@ -0,0 +1 @@
+ALTER TABLE "games" ADD COLUMN "depth" int NOT NULL DEFAULT 1;
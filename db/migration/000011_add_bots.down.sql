@@ -0,0 +1,5 @@
+DELETE FROM "users" u
+WHERE u."username" IN ('bot_easy', 'bot_medium', 'bot_hard', 'bot_perfect')
+  AND NOT EXISTS (SELECT 1 FROM "game_participants" p WHERE p."user_id" = u."id");
+
+ALTER TABLE "games" DROP COLUMN IF EXISTS "rated";
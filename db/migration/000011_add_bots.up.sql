@@ -0,0 +1,10 @@
+ALTER TABLE "games" ADD COLUMN "rated" boolean NOT NULL DEFAULT true;
+
+-- Accounts the built-in bots play under; no password hash matches "!", so
+-- nobody can log in as a bot
+INSERT INTO "users" ("username", "password_hash") VALUES
+    ('bot_easy', '!'),
+    ('bot_medium', '!'),
+    ('bot_hard', '!'),
+    ('bot_perfect', '!')
+ON CONFLICT ("username") DO NOTHING;
@@ -0,0 +1,3 @@
+DELETE FROM "users" u
+WHERE u."username" = 'bot_mcts'
+  AND NOT EXISTS (SELECT 1 FROM "game_participants" p WHERE p."user_id" = u."id");
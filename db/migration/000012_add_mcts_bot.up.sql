@@ -0,0 +1,3 @@
+INSERT INTO "users" ("username", "password_hash") VALUES
+    ('bot_mcts', '!')
+ON CONFLICT ("username") DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE "games" ADD COLUMN "hints" boolean NOT NULL DEFAULT false;
+
+-- Hints are only ever offered in unrated games
+UPDATE "games" SET "hints" = true WHERE NOT "rated";
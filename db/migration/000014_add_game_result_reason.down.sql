@@ -0,0 +1,2 @@
+ALTER TABLE "games" DROP COLUMN IF EXISTS "resigned_user_id";
+ALTER TABLE "games" DROP COLUMN IF EXISTS "result_reason";
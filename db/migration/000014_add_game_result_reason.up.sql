@@ -0,0 +1,7 @@
+ALTER TABLE "games" ADD COLUMN "result_reason" varchar;
+ALTER TABLE "games" ADD COLUMN "resigned_user_id" bigint;
+
+ALTER TABLE "games" ADD FOREIGN KEY ("resigned_user_id") REFERENCES "users" ("id");
+
+-- Every game finished so far was played out on the board
+UPDATE "games" SET "result_reason" = 'played_out' WHERE "status" IN ('completed', 'imported');
@@ -0,0 +1,2 @@
+-- Renamed accounts keep their new names and the bots keep their accounts
+SELECT 1;
@@ -0,0 +1,14 @@
+-- Accounts created before bot usernames were reserved may hold the name of a
+-- bot, which the bot migrations then left alone. Move them aside under a name
+-- derived from their id, so the bots get accounts nobody can log in to.
+UPDATE "users" SET "username" = 'renamed_' || "id"
+WHERE "username" IN ('bot_easy', 'bot_medium', 'bot_hard', 'bot_perfect', 'bot_mcts')
+  AND "password_hash" <> '!';
+
+INSERT INTO "users" ("username", "password_hash") VALUES
+    ('bot_easy', '!'),
+    ('bot_medium', '!'),
+    ('bot_hard', '!'),
+    ('bot_perfect', '!'),
+    ('bot_mcts', '!')
+ON CONFLICT ("username") DO NOTHING;
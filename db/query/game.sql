@@ -0,0 +1,48 @@
+-- name: CreateGame :one
+INSERT INTO games (code, host_user_id, status, current_state, next_turn_user_id, variant, width, height, win_length, depth, rated, hints)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING *;
+
+-- name: GetGame :one
+SELECT * FROM games WHERE code = $1 LIMIT 1;
+
+-- name: GetGameForUpdate :one
+SELECT * FROM games WHERE code = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: UpdateGame :one
+UPDATE games SET status = $2, current_state = $3, next_turn_user_id = $4 WHERE id = $1 RETURNING *;
+
+-- name: ListActiveGames :many
+SELECT * FROM games WHERE status IN ('waiting', 'in_progress') ORDER BY id;
+
+-- name: ListAvailableGames :many
+SELECT g.code, g.created_at, g.variant, g.width, g.height, g.win_length, g.depth, u.username AS host_username
+FROM games g
+JOIN users u ON u.id = g.host_user_id
+WHERE g.status = 'waiting'
+ORDER BY g.created_at DESC, g.id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: CountAvailableGames :one
+SELECT count(*) FROM games WHERE status = 'waiting';
+
+-- name: FinishGame :one
+UPDATE games SET winner_user_id = $2, finished_at = now(), result_reason = $3, resigned_user_id = $4 WHERE id = $1 RETURNING *;
+
+-- name: AbandonGame :one
+UPDATE games SET status = 'abandoned', next_turn_user_id = NULL, finished_at = now(), result_reason = 'abandoned'
+WHERE code = $1 AND status IN ('waiting', 'in_progress')
+RETURNING *;
+
+-- name: GetGameResult :one
+SELECT g.result_reason, w.username AS winner_username, r.username AS resigned_username
+FROM games g
+LEFT JOIN users w ON w.id = g.winner_user_id
+LEFT JOIN users r ON r.id = g.resigned_user_id
+WHERE g.id = $1;
+
+-- name: ImportGame :one
+INSERT INTO games (code, host_user_id, status, current_state, winner_user_id, created_at, finished_at, variant, width, height, win_length, depth, result_reason, resigned_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
@@ -0,0 +1,15 @@
+-- name: CreateGameMove :one
+INSERT INTO game_moves (game_id, move_number, user_id, position, symbol) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: CountGameMoves :one
+SELECT count(*) FROM game_moves WHERE game_id = $1;
+
+-- name: ListGameMoves :many
+SELECT gm.*, u.username
+FROM game_moves gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.game_id = $1
+ORDER BY gm.move_number;
+
+-- name: ImportGameMove :one
+INSERT INTO game_moves (game_id, move_number, user_id, position, symbol, created_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
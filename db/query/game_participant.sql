@@ -0,0 +1,12 @@
+-- name: CreateGameParticipant :one
+INSERT INTO game_participants (game_id, user_id) VALUES ($1, $2) RETURNING *;
+
+-- name: ListGameParticipants :many
+SELECT * FROM game_participants WHERE game_id = $1 ORDER BY id;
+
+-- name: ListGameParticipantUsernames :many
+SELECT u.username
+FROM game_participants gp
+JOIN users u ON u.id = gp.user_id
+WHERE gp.game_id = $1
+ORDER BY gp.id;
@@ -0,0 +1,65 @@
+-- name: ListLeaderboard :many
+WITH stats AS (
+    SELECT
+        u.username,
+        u.rating,
+        count(rh.id) AS games_played,
+        count(rh.id) FILTER (WHERE rh.result = 'win') AS wins,
+        count(rh.id) FILTER (WHERE rh.result = 'loss') AS losses,
+        count(rh.id) FILTER (WHERE rh.result = 'draw') AS draws
+    FROM users u
+    JOIN rating_history rh ON rh.user_id = u.id
+    WHERE rh.created_at >= sqlc.arg(since)
+        AND (cardinality(sqlc.arg(usernames)::varchar[]) = 0 OR u.username = ANY(sqlc.arg(usernames)::varchar[]))
+    GROUP BY u.id
+), ranked AS (
+    SELECT
+        stats.*,
+        (stats.wins::float8 / stats.games_played)::float8 AS win_rate,
+        rank() OVER (ORDER BY CASE sqlc.arg(order_by)::varchar
+            WHEN 'wins' THEN stats.wins::float8
+            WHEN 'win_rate' THEN stats.wins::float8 / stats.games_played
+            ELSE stats.rating
+        END DESC) AS rank
+    FROM stats
+)
+SELECT * FROM ranked
+ORDER BY rank, username
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
+
+-- name: GetLeaderboardEntry :one
+WITH stats AS (
+    SELECT
+        u.username,
+        u.rating,
+        count(rh.id) AS games_played,
+        count(rh.id) FILTER (WHERE rh.result = 'win') AS wins,
+        count(rh.id) FILTER (WHERE rh.result = 'loss') AS losses,
+        count(rh.id) FILTER (WHERE rh.result = 'draw') AS draws
+    FROM users u
+    JOIN rating_history rh ON rh.user_id = u.id
+    WHERE rh.created_at >= sqlc.arg(since)
+        AND (cardinality(sqlc.arg(usernames)::varchar[]) = 0 OR u.username = ANY(sqlc.arg(usernames)::varchar[]))
+    GROUP BY u.id
+), ranked AS (
+    SELECT
+        stats.*,
+        (stats.wins::float8 / stats.games_played)::float8 AS win_rate,
+        rank() OVER (ORDER BY CASE sqlc.arg(order_by)::varchar
+            WHEN 'wins' THEN stats.wins::float8
+            WHEN 'win_rate' THEN stats.wins::float8 / stats.games_played
+            ELSE stats.rating
+        END DESC) AS rank
+    FROM stats
+)
+SELECT * FROM ranked
+WHERE username = sqlc.arg(username)
+LIMIT 1;
+
+-- name: CountLeaderboard :one
+SELECT count(DISTINCT rh.user_id)
+FROM rating_history rh
+JOIN users u ON u.id = rh.user_id
+WHERE rh.created_at >= sqlc.arg(since)
+    AND (cardinality(sqlc.arg(usernames)::varchar[]) = 0 OR u.username = ANY(sqlc.arg(usernames)::varchar[]));
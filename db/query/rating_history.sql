@@ -0,0 +1,11 @@
+-- name: CreateRatingHistory :one
+INSERT INTO rating_history (
+    user_id,
+    game_id,
+    result,
+    rating_before,
+    rating_after,
+    rating_deviation_before,
+    rating_deviation_after,
+    rating_volatility_after
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
@@ -2,4 +2,10 @@
 INSERT INTO users (username, password_hash) VALUES ($1, $2) RETURNING *;
 
 -- name: GetUser :one
-SELECT * FROM users WHERE username = $1 LIMIT 1;
\ No newline at end of file
+SELECT * FROM users WHERE username = $1 LIMIT 1;
+
+-- name: GetUserByIDForUpdate :one
+SELECT * FROM users WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE;
+
+-- name: UpdateUserRating :one
+UPDATE users SET rating = $2, rating_deviation = $3, rating_volatility = $4 WHERE id = $1 RETURNING *;
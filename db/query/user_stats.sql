@@ -0,0 +1,48 @@
+-- name: GetUserStats :one
+SELECT
+    count(*) AS games_played,
+    count(*) FILTER (WHERE g.winner_user_id = gp.user_id) AS wins,
+    count(*) FILTER (WHERE g.winner_user_id <> gp.user_id) AS losses,
+    count(*) FILTER (WHERE g.winner_user_id IS NULL) AS draws
+FROM games g
+JOIN game_participants gp ON gp.game_id = g.id
+WHERE gp.user_id = sqlc.arg(user_id)::bigint
+    AND g.status = 'completed';
+
+-- name: GetHeadToHead :one
+SELECT
+    count(*) AS games_played,
+    count(*) FILTER (WHERE g.winner_user_id = me.user_id) AS wins,
+    count(*) FILTER (WHERE g.winner_user_id = op.user_id) AS losses,
+    count(*) FILTER (WHERE g.winner_user_id IS NULL) AS draws
+FROM games g
+JOIN game_participants me ON me.game_id = g.id AND me.user_id = sqlc.arg(user_id)::bigint
+JOIN game_participants op ON op.game_id = g.id AND op.user_id = sqlc.arg(opponent_id)::bigint
+WHERE g.status = 'completed';
+
+-- name: ListUserGames :many
+SELECT * FROM (
+    SELECT
+        g.id,
+        g.code,
+        g.created_at,
+        g.finished_at,
+        o.username AS opponent_username,
+        (CASE
+            WHEN g.winner_user_id IS NULL THEN 'draw'
+            WHEN g.winner_user_id = me.user_id THEN 'win'
+            ELSE 'loss'
+        END)::varchar AS result
+    FROM games g
+    JOIN game_participants me ON me.game_id = g.id AND me.user_id = sqlc.arg(user_id)::bigint
+    JOIN game_participants op ON op.game_id = g.id AND op.user_id <> me.user_id
+    JOIN users o ON o.id = op.user_id
+    WHERE g.status = 'completed'
+        AND g.id < sqlc.arg(before_id)::bigint
+        AND g.finished_at >= sqlc.arg(finished_after)::timestamptz
+        AND g.finished_at < sqlc.arg(finished_before)::timestamptz
+        AND (sqlc.arg(opponent)::varchar = '' OR o.username = sqlc.arg(opponent)::varchar)
+) user_games
+WHERE sqlc.arg(result)::varchar = '' OR user_games.result = sqlc.arg(result)::varchar
+ORDER BY user_games.id DESC
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrRecordNotFound is returned by queries that match no rows
+var ErrRecordNotFound = pgx.ErrNoRows
+
+// ErrGameNotWaiting is returned when joining a game that is no longer
+// waiting for its second player
+var ErrGameNotWaiting = errors.New("game is not waiting for a player")
+
+// UniqueViolation is the PostgreSQL error code for a duplicate key
+const UniqueViolation = "23505"
+
+// ErrorCode returns the PostgreSQL error code of err, or "" if it has none
+func ErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
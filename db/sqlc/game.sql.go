@@ -0,0 +1,443 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: game.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const abandonGame = `-- name: AbandonGame :one
+UPDATE games SET status = 'abandoned', next_turn_user_id = NULL, finished_at = now(), result_reason = 'abandoned'
+WHERE code = $1 AND status IN ('waiting', 'in_progress')
+RETURNING id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id
+`
+
+func (q *Queries) AbandonGame(ctx context.Context, code string) (Game, error) {
+	row := q.db.QueryRow(ctx, abandonGame, code)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const countAvailableGames = `-- name: CountAvailableGames :one
+SELECT count(*) FROM games WHERE status = 'waiting'
+`
+
+func (q *Queries) CountAvailableGames(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countAvailableGames)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createGame = `-- name: CreateGame :one
+INSERT INTO games (code, host_user_id, status, current_state, next_turn_user_id, variant, width, height, win_length, depth, rated, hints)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id
+`
+
+type CreateGameParams struct {
+	Code           string      `json:"code"`
+	HostUserID     pgtype.Int8 `json:"host_user_id"`
+	Status         string      `json:"status"`
+	CurrentState   pgtype.Text `json:"current_state"`
+	NextTurnUserID pgtype.Int8 `json:"next_turn_user_id"`
+	Variant        string      `json:"variant"`
+	Width          int32       `json:"width"`
+	Height         int32       `json:"height"`
+	WinLength      int32       `json:"win_length"`
+	Depth          int32       `json:"depth"`
+	Rated          bool        `json:"rated"`
+	Hints          bool        `json:"hints"`
+}
+
+func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, createGame,
+		arg.Code,
+		arg.HostUserID,
+		arg.Status,
+		arg.CurrentState,
+		arg.NextTurnUserID,
+		arg.Variant,
+		arg.Width,
+		arg.Height,
+		arg.WinLength,
+		arg.Depth,
+		arg.Rated,
+		arg.Hints,
+	)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const finishGame = `-- name: FinishGame :one
+UPDATE games SET winner_user_id = $2, finished_at = now(), result_reason = $3, resigned_user_id = $4 WHERE id = $1 RETURNING id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id
+`
+
+type FinishGameParams struct {
+	ID             int64       `json:"id"`
+	WinnerUserID   pgtype.Int8 `json:"winner_user_id"`
+	ResultReason   pgtype.Text `json:"result_reason"`
+	ResignedUserID pgtype.Int8 `json:"resigned_user_id"`
+}
+
+func (q *Queries) FinishGame(ctx context.Context, arg FinishGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, finishGame,
+		arg.ID,
+		arg.WinnerUserID,
+		arg.ResultReason,
+		arg.ResignedUserID,
+	)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const getGame = `-- name: GetGame :one
+SELECT id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id FROM games WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetGame(ctx context.Context, code string) (Game, error) {
+	row := q.db.QueryRow(ctx, getGame, code)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const getGameForUpdate = `-- name: GetGameForUpdate :one
+SELECT id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id FROM games WHERE code = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetGameForUpdate(ctx context.Context, code string) (Game, error) {
+	row := q.db.QueryRow(ctx, getGameForUpdate, code)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const getGameResult = `-- name: GetGameResult :one
+SELECT g.result_reason, w.username AS winner_username, r.username AS resigned_username
+FROM games g
+LEFT JOIN users w ON w.id = g.winner_user_id
+LEFT JOIN users r ON r.id = g.resigned_user_id
+WHERE g.id = $1
+`
+
+type GetGameResultRow struct {
+	ResultReason     pgtype.Text `json:"result_reason"`
+	WinnerUsername   pgtype.Text `json:"winner_username"`
+	ResignedUsername pgtype.Text `json:"resigned_username"`
+}
+
+func (q *Queries) GetGameResult(ctx context.Context, id int64) (GetGameResultRow, error) {
+	row := q.db.QueryRow(ctx, getGameResult, id)
+	var i GetGameResultRow
+	err := row.Scan(&i.ResultReason, &i.WinnerUsername, &i.ResignedUsername)
+	return i, err
+}
+
+const importGame = `-- name: ImportGame :one
+INSERT INTO games (code, host_user_id, status, current_state, winner_user_id, created_at, finished_at, variant, width, height, win_length, depth, result_reason, resigned_user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id
+`
+
+type ImportGameParams struct {
+	Code           string             `json:"code"`
+	HostUserID     pgtype.Int8        `json:"host_user_id"`
+	Status         string             `json:"status"`
+	CurrentState   pgtype.Text        `json:"current_state"`
+	WinnerUserID   pgtype.Int8        `json:"winner_user_id"`
+	CreatedAt      time.Time          `json:"created_at"`
+	FinishedAt     pgtype.Timestamptz `json:"finished_at"`
+	Variant        string             `json:"variant"`
+	Width          int32              `json:"width"`
+	Height         int32              `json:"height"`
+	WinLength      int32              `json:"win_length"`
+	Depth          int32              `json:"depth"`
+	ResultReason   pgtype.Text        `json:"result_reason"`
+	ResignedUserID pgtype.Int8        `json:"resigned_user_id"`
+}
+
+func (q *Queries) ImportGame(ctx context.Context, arg ImportGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, importGame,
+		arg.Code,
+		arg.HostUserID,
+		arg.Status,
+		arg.CurrentState,
+		arg.WinnerUserID,
+		arg.CreatedAt,
+		arg.FinishedAt,
+		arg.Variant,
+		arg.Width,
+		arg.Height,
+		arg.WinLength,
+		arg.Depth,
+		arg.ResultReason,
+		arg.ResignedUserID,
+	)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
+
+const listActiveGames = `-- name: ListActiveGames :many
+SELECT id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id FROM games WHERE status IN ('waiting', 'in_progress') ORDER BY id
+`
+
+func (q *Queries) ListActiveGames(ctx context.Context) ([]Game, error) {
+	rows, err := q.db.Query(ctx, listActiveGames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Game{}
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.HostUserID,
+			&i.Status,
+			&i.CurrentState,
+			&i.NextTurnUserID,
+			&i.Code,
+			&i.CreatedAt,
+			&i.WinnerUserID,
+			&i.FinishedAt,
+			&i.Width,
+			&i.Height,
+			&i.WinLength,
+			&i.Variant,
+			&i.Depth,
+			&i.Rated,
+			&i.Hints,
+			&i.ResultReason,
+			&i.ResignedUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAvailableGames = `-- name: ListAvailableGames :many
+SELECT g.code, g.created_at, g.variant, g.width, g.height, g.win_length, g.depth, u.username AS host_username
+FROM games g
+JOIN users u ON u.id = g.host_user_id
+WHERE g.status = 'waiting'
+ORDER BY g.created_at DESC, g.id DESC
+LIMIT $1
+OFFSET $2
+`
+
+type ListAvailableGamesParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListAvailableGamesRow struct {
+	Code         string    `json:"code"`
+	CreatedAt    time.Time `json:"created_at"`
+	Variant      string    `json:"variant"`
+	Width        int32     `json:"width"`
+	Height       int32     `json:"height"`
+	WinLength    int32     `json:"win_length"`
+	Depth        int32     `json:"depth"`
+	HostUsername string    `json:"host_username"`
+}
+
+func (q *Queries) ListAvailableGames(ctx context.Context, arg ListAvailableGamesParams) ([]ListAvailableGamesRow, error) {
+	rows, err := q.db.Query(ctx, listAvailableGames, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAvailableGamesRow{}
+	for rows.Next() {
+		var i ListAvailableGamesRow
+		if err := rows.Scan(
+			&i.Code,
+			&i.CreatedAt,
+			&i.Variant,
+			&i.Width,
+			&i.Height,
+			&i.WinLength,
+			&i.Depth,
+			&i.HostUsername,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateGame = `-- name: UpdateGame :one
+UPDATE games SET status = $2, current_state = $3, next_turn_user_id = $4 WHERE id = $1 RETURNING id, host_user_id, status, current_state, next_turn_user_id, code, created_at, winner_user_id, finished_at, width, height, win_length, variant, depth, rated, hints, result_reason, resigned_user_id
+`
+
+type UpdateGameParams struct {
+	ID             int64       `json:"id"`
+	Status         string      `json:"status"`
+	CurrentState   pgtype.Text `json:"current_state"`
+	NextTurnUserID pgtype.Int8 `json:"next_turn_user_id"`
+}
+
+func (q *Queries) UpdateGame(ctx context.Context, arg UpdateGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, updateGame,
+		arg.ID,
+		arg.Status,
+		arg.CurrentState,
+		arg.NextTurnUserID,
+	)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostUserID,
+		&i.Status,
+		&i.CurrentState,
+		&i.NextTurnUserID,
+		&i.Code,
+		&i.CreatedAt,
+		&i.WinnerUserID,
+		&i.FinishedAt,
+		&i.Width,
+		&i.Height,
+		&i.WinLength,
+		&i.Variant,
+		&i.Depth,
+		&i.Rated,
+		&i.Hints,
+		&i.ResultReason,
+		&i.ResignedUserID,
+	)
+	return i, err
+}
@@ -0,0 +1,138 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: game_move.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const countGameMoves = `-- name: CountGameMoves :one
+SELECT count(*) FROM game_moves WHERE game_id = $1
+`
+
+func (q *Queries) CountGameMoves(ctx context.Context, gameID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countGameMoves, gameID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createGameMove = `-- name: CreateGameMove :one
+INSERT INTO game_moves (game_id, move_number, user_id, position, symbol) VALUES ($1, $2, $3, $4, $5) RETURNING id, game_id, move_number, user_id, position, symbol, created_at
+`
+
+type CreateGameMoveParams struct {
+	GameID     int64  `json:"game_id"`
+	MoveNumber int32  `json:"move_number"`
+	UserID     int64  `json:"user_id"`
+	Position   int32  `json:"position"`
+	Symbol     string `json:"symbol"`
+}
+
+func (q *Queries) CreateGameMove(ctx context.Context, arg CreateGameMoveParams) (GameMove, error) {
+	row := q.db.QueryRow(ctx, createGameMove,
+		arg.GameID,
+		arg.MoveNumber,
+		arg.UserID,
+		arg.Position,
+		arg.Symbol,
+	)
+	var i GameMove
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.MoveNumber,
+		&i.UserID,
+		&i.Position,
+		&i.Symbol,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const importGameMove = `-- name: ImportGameMove :one
+INSERT INTO game_moves (game_id, move_number, user_id, position, symbol, created_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, game_id, move_number, user_id, position, symbol, created_at
+`
+
+type ImportGameMoveParams struct {
+	GameID     int64     `json:"game_id"`
+	MoveNumber int32     `json:"move_number"`
+	UserID     int64     `json:"user_id"`
+	Position   int32     `json:"position"`
+	Symbol     string    `json:"symbol"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (q *Queries) ImportGameMove(ctx context.Context, arg ImportGameMoveParams) (GameMove, error) {
+	row := q.db.QueryRow(ctx, importGameMove,
+		arg.GameID,
+		arg.MoveNumber,
+		arg.UserID,
+		arg.Position,
+		arg.Symbol,
+		arg.CreatedAt,
+	)
+	var i GameMove
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.MoveNumber,
+		&i.UserID,
+		&i.Position,
+		&i.Symbol,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listGameMoves = `-- name: ListGameMoves :many
+SELECT gm.id, gm.game_id, gm.move_number, gm.user_id, gm.position, gm.symbol, gm.created_at, u.username
+FROM game_moves gm
+JOIN users u ON u.id = gm.user_id
+WHERE gm.game_id = $1
+ORDER BY gm.move_number
+`
+
+type ListGameMovesRow struct {
+	ID         int64     `json:"id"`
+	GameID     int64     `json:"game_id"`
+	MoveNumber int32     `json:"move_number"`
+	UserID     int64     `json:"user_id"`
+	Position   int32     `json:"position"`
+	Symbol     string    `json:"symbol"`
+	CreatedAt  time.Time `json:"created_at"`
+	Username   string    `json:"username"`
+}
+
+func (q *Queries) ListGameMoves(ctx context.Context, gameID int64) ([]ListGameMovesRow, error) {
+	rows, err := q.db.Query(ctx, listGameMoves, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListGameMovesRow{}
+	for rows.Next() {
+		var i ListGameMovesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.MoveNumber,
+			&i.UserID,
+			&i.Position,
+			&i.Symbol,
+			&i.CreatedAt,
+			&i.Username,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: game_participant.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createGameParticipant = `-- name: CreateGameParticipant :one
+INSERT INTO game_participants (game_id, user_id) VALUES ($1, $2) RETURNING id, game_id, user_id
+`
+
+type CreateGameParticipantParams struct {
+	GameID pgtype.Int8 `json:"game_id"`
+	UserID pgtype.Int8 `json:"user_id"`
+}
+
+func (q *Queries) CreateGameParticipant(ctx context.Context, arg CreateGameParticipantParams) (GameParticipant, error) {
+	row := q.db.QueryRow(ctx, createGameParticipant, arg.GameID, arg.UserID)
+	var i GameParticipant
+	err := row.Scan(&i.ID, &i.GameID, &i.UserID)
+	return i, err
+}
+
+const listGameParticipantUsernames = `-- name: ListGameParticipantUsernames :many
+SELECT u.username
+FROM game_participants gp
+JOIN users u ON u.id = gp.user_id
+WHERE gp.game_id = $1
+ORDER BY gp.id
+`
+
+func (q *Queries) ListGameParticipantUsernames(ctx context.Context, gameID pgtype.Int8) ([]string, error) {
+	rows, err := q.db.Query(ctx, listGameParticipantUsernames, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			return nil, err
+		}
+		items = append(items, username)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameParticipants = `-- name: ListGameParticipants :many
+SELECT id, game_id, user_id FROM game_participants WHERE game_id = $1 ORDER BY id
+`
+
+func (q *Queries) ListGameParticipants(ctx context.Context, gameID pgtype.Int8) ([]GameParticipant, error) {
+	rows, err := q.db.Query(ctx, listGameParticipants, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GameParticipant{}
+	for rows.Next() {
+		var i GameParticipant
+		if err := rows.Scan(&i.ID, &i.GameID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
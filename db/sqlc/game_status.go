@@ -0,0 +1,29 @@
+package db
+
+// Values stored in games.status
+const (
+	GameStatusWaiting    = "waiting"
+	GameStatusInProgress = "in_progress"
+	GameStatusCompleted  = "completed"
+	// GameStatusImported marks finished games loaded from notation
+	GameStatusImported = "imported"
+	// GameStatusAbandoned marks games ended without a result before they were
+	// played, e.g. when a matched player stopped waiting
+	GameStatusAbandoned = "abandoned"
+)
+
+// Values stored in rating_history.result
+const (
+	GameResultWin  = "win"
+	GameResultLoss = "loss"
+	GameResultDraw = "draw"
+)
+
+// Values stored in games.result_reason
+const (
+	// GameReasonPlayedOut marks games the rules decided on the board
+	GameReasonPlayedOut   = "played_out"
+	GameReasonResignation = "resignation"
+	GameReasonDrawAgreed  = "draw_agreed"
+	GameReasonAbandoned   = "abandoned"
+)
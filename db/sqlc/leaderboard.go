@@ -0,0 +1,8 @@
+package db
+
+// Orderings accepted by the leaderboard queries
+const (
+	LeaderboardOrderRating  = "rating"
+	LeaderboardOrderWins    = "wins"
+	LeaderboardOrderWinRate = "win_rate"
+)
@@ -0,0 +1,185 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: leaderboard.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const countLeaderboard = `-- name: CountLeaderboard :one
+SELECT count(DISTINCT rh.user_id)
+FROM rating_history rh
+JOIN users u ON u.id = rh.user_id
+WHERE rh.created_at >= $1
+    AND (cardinality($2::varchar[]) = 0 OR u.username = ANY($2::varchar[]))
+`
+
+type CountLeaderboardParams struct {
+	Since     time.Time `json:"since"`
+	Usernames []string  `json:"usernames"`
+}
+
+func (q *Queries) CountLeaderboard(ctx context.Context, arg CountLeaderboardParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLeaderboard, arg.Since, arg.Usernames)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getLeaderboardEntry = `-- name: GetLeaderboardEntry :one
+WITH stats AS (
+    SELECT
+        u.username,
+        u.rating,
+        count(rh.id) AS games_played,
+        count(rh.id) FILTER (WHERE rh.result = 'win') AS wins,
+        count(rh.id) FILTER (WHERE rh.result = 'loss') AS losses,
+        count(rh.id) FILTER (WHERE rh.result = 'draw') AS draws
+    FROM users u
+    JOIN rating_history rh ON rh.user_id = u.id
+    WHERE rh.created_at >= $2
+        AND (cardinality($3::varchar[]) = 0 OR u.username = ANY($3::varchar[]))
+    GROUP BY u.id
+), ranked AS (
+    SELECT
+        stats.username, stats.rating, stats.games_played, stats.wins, stats.losses, stats.draws,
+        (stats.wins::float8 / stats.games_played)::float8 AS win_rate,
+        rank() OVER (ORDER BY CASE $4::varchar
+            WHEN 'wins' THEN stats.wins::float8
+            WHEN 'win_rate' THEN stats.wins::float8 / stats.games_played
+            ELSE stats.rating
+        END DESC) AS rank
+    FROM stats
+)
+SELECT username, rating, games_played, wins, losses, draws, win_rate, rank FROM ranked
+WHERE username = $1
+LIMIT 1
+`
+
+type GetLeaderboardEntryParams struct {
+	Username  string    `json:"username"`
+	Since     time.Time `json:"since"`
+	Usernames []string  `json:"usernames"`
+	OrderBy   string    `json:"order_by"`
+}
+
+type GetLeaderboardEntryRow struct {
+	Username    string  `json:"username"`
+	Rating      float64 `json:"rating"`
+	GamesPlayed int64   `json:"games_played"`
+	Wins        int64   `json:"wins"`
+	Losses      int64   `json:"losses"`
+	Draws       int64   `json:"draws"`
+	WinRate     float64 `json:"win_rate"`
+	Rank        int64   `json:"rank"`
+}
+
+func (q *Queries) GetLeaderboardEntry(ctx context.Context, arg GetLeaderboardEntryParams) (GetLeaderboardEntryRow, error) {
+	row := q.db.QueryRow(ctx, getLeaderboardEntry,
+		arg.Username,
+		arg.Since,
+		arg.Usernames,
+		arg.OrderBy,
+	)
+	var i GetLeaderboardEntryRow
+	err := row.Scan(
+		&i.Username,
+		&i.Rating,
+		&i.GamesPlayed,
+		&i.Wins,
+		&i.Losses,
+		&i.Draws,
+		&i.WinRate,
+		&i.Rank,
+	)
+	return i, err
+}
+
+const listLeaderboard = `-- name: ListLeaderboard :many
+WITH stats AS (
+    SELECT
+        u.username,
+        u.rating,
+        count(rh.id) AS games_played,
+        count(rh.id) FILTER (WHERE rh.result = 'win') AS wins,
+        count(rh.id) FILTER (WHERE rh.result = 'loss') AS losses,
+        count(rh.id) FILTER (WHERE rh.result = 'draw') AS draws
+    FROM users u
+    JOIN rating_history rh ON rh.user_id = u.id
+    WHERE rh.created_at >= $3
+        AND (cardinality($4::varchar[]) = 0 OR u.username = ANY($4::varchar[]))
+    GROUP BY u.id
+), ranked AS (
+    SELECT
+        stats.username, stats.rating, stats.games_played, stats.wins, stats.losses, stats.draws,
+        (stats.wins::float8 / stats.games_played)::float8 AS win_rate,
+        rank() OVER (ORDER BY CASE $5::varchar
+            WHEN 'wins' THEN stats.wins::float8
+            WHEN 'win_rate' THEN stats.wins::float8 / stats.games_played
+            ELSE stats.rating
+        END DESC) AS rank
+    FROM stats
+)
+SELECT username, rating, games_played, wins, losses, draws, win_rate, rank FROM ranked
+ORDER BY rank, username
+LIMIT $2
+OFFSET $1
+`
+
+type ListLeaderboardParams struct {
+	OffsetCount int32     `json:"offset_count"`
+	LimitCount  int32     `json:"limit_count"`
+	Since       time.Time `json:"since"`
+	Usernames   []string  `json:"usernames"`
+	OrderBy     string    `json:"order_by"`
+}
+
+type ListLeaderboardRow struct {
+	Username    string  `json:"username"`
+	Rating      float64 `json:"rating"`
+	GamesPlayed int64   `json:"games_played"`
+	Wins        int64   `json:"wins"`
+	Losses      int64   `json:"losses"`
+	Draws       int64   `json:"draws"`
+	WinRate     float64 `json:"win_rate"`
+	Rank        int64   `json:"rank"`
+}
+
+func (q *Queries) ListLeaderboard(ctx context.Context, arg ListLeaderboardParams) ([]ListLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, listLeaderboard,
+		arg.OffsetCount,
+		arg.LimitCount,
+		arg.Since,
+		arg.Usernames,
+		arg.OrderBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLeaderboardRow{}
+	for rows.Next() {
+		var i ListLeaderboardRow
+		if err := rows.Scan(
+			&i.Username,
+			&i.Rating,
+			&i.GamesPlayed,
+			&i.Wins,
+			&i.Losses,
+			&i.Draws,
+			&i.WinRate,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
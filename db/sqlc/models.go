@@ -12,11 +12,34 @@ import (
 )
 
 type Game struct {
-	ID             int64       `json:"id"`
-	HostUserID     pgtype.Int8 `json:"host_user_id"`
-	Status         string      `json:"status"`
-	CurrentState   pgtype.Text `json:"current_state"`
-	NextTurnUserID pgtype.Int8 `json:"next_turn_user_id"`
+	ID             int64              `json:"id"`
+	HostUserID     pgtype.Int8        `json:"host_user_id"`
+	Status         string             `json:"status"`
+	CurrentState   pgtype.Text        `json:"current_state"`
+	NextTurnUserID pgtype.Int8        `json:"next_turn_user_id"`
+	Code           string             `json:"code"`
+	CreatedAt      time.Time          `json:"created_at"`
+	WinnerUserID   pgtype.Int8        `json:"winner_user_id"`
+	FinishedAt     pgtype.Timestamptz `json:"finished_at"`
+	Width          int32              `json:"width"`
+	Height         int32              `json:"height"`
+	WinLength      int32              `json:"win_length"`
+	Variant        string             `json:"variant"`
+	Depth          int32              `json:"depth"`
+	Rated          bool               `json:"rated"`
+	Hints          bool               `json:"hints"`
+	ResultReason   pgtype.Text        `json:"result_reason"`
+	ResignedUserID pgtype.Int8        `json:"resigned_user_id"`
+}
+
+type GameMove struct {
+	ID         int64     `json:"id"`
+	GameID     int64     `json:"game_id"`
+	MoveNumber int32     `json:"move_number"`
+	UserID     int64     `json:"user_id"`
+	Position   int32     `json:"position"`
+	Symbol     string    `json:"symbol"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 type GameParticipant struct {
@@ -25,6 +48,19 @@ type GameParticipant struct {
 	UserID pgtype.Int8 `json:"user_id"`
 }
 
+type RatingHistory struct {
+	ID                    int64     `json:"id"`
+	UserID                int64     `json:"user_id"`
+	GameID                int64     `json:"game_id"`
+	Result                string    `json:"result"`
+	RatingBefore          float64   `json:"rating_before"`
+	RatingAfter           float64   `json:"rating_after"`
+	RatingDeviationBefore float64   `json:"rating_deviation_before"`
+	RatingDeviationAfter  float64   `json:"rating_deviation_after"`
+	RatingVolatilityAfter float64   `json:"rating_volatility_after"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
 type Session struct {
 	ID           uuid.UUID `json:"id"`
 	Username     string    `json:"username"`
@@ -37,8 +73,11 @@ type Session struct {
 }
 
 type User struct {
-	ID           int64     `json:"id"`
-	Username     string    `json:"username"`
-	PasswordHash string    `json:"password_hash"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID               int64     `json:"id"`
+	Username         string    `json:"username"`
+	PasswordHash     string    `json:"password_hash"`
+	CreatedAt        time.Time `json:"created_at"`
+	Rating           float64   `json:"rating"`
+	RatingDeviation  float64   `json:"rating_deviation"`
+	RatingVolatility float64   `json:"rating_volatility"`
 }
@@ -8,13 +8,41 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AbandonGame(ctx context.Context, code string) (Game, error)
+	CountAvailableGames(ctx context.Context) (int64, error)
+	CountGameMoves(ctx context.Context, gameID int64) (int64, error)
+	CountLeaderboard(ctx context.Context, arg CountLeaderboardParams) (int64, error)
+	CreateGame(ctx context.Context, arg CreateGameParams) (Game, error)
+	CreateGameMove(ctx context.Context, arg CreateGameMoveParams) (GameMove, error)
+	CreateGameParticipant(ctx context.Context, arg CreateGameParticipantParams) (GameParticipant, error)
+	CreateRatingHistory(ctx context.Context, arg CreateRatingHistoryParams) (RatingHistory, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	FinishGame(ctx context.Context, arg FinishGameParams) (Game, error)
+	GetGame(ctx context.Context, code string) (Game, error)
+	GetGameForUpdate(ctx context.Context, code string) (Game, error)
+	GetGameResult(ctx context.Context, id int64) (GetGameResultRow, error)
+	GetHeadToHead(ctx context.Context, arg GetHeadToHeadParams) (GetHeadToHeadRow, error)
+	GetLeaderboardEntry(ctx context.Context, arg GetLeaderboardEntryParams) (GetLeaderboardEntryRow, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByIDForUpdate(ctx context.Context, id int64) (User, error)
+	GetUserStats(ctx context.Context, userID int64) (GetUserStatsRow, error)
+	ImportGame(ctx context.Context, arg ImportGameParams) (Game, error)
+	ImportGameMove(ctx context.Context, arg ImportGameMoveParams) (GameMove, error)
+	ListActiveGames(ctx context.Context) ([]Game, error)
+	ListAvailableGames(ctx context.Context, arg ListAvailableGamesParams) ([]ListAvailableGamesRow, error)
+	ListGameMoves(ctx context.Context, gameID int64) ([]ListGameMovesRow, error)
+	ListGameParticipantUsernames(ctx context.Context, gameID pgtype.Int8) ([]string, error)
+	ListGameParticipants(ctx context.Context, gameID pgtype.Int8) ([]GameParticipant, error)
+	ListLeaderboard(ctx context.Context, arg ListLeaderboardParams) ([]ListLeaderboardRow, error)
+	ListUserGames(ctx context.Context, arg ListUserGamesParams) ([]ListUserGamesRow, error)
+	UpdateGame(ctx context.Context, arg UpdateGameParams) (Game, error)
+	UpdateUserRating(ctx context.Context, arg UpdateUserRatingParams) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,61 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: rating_history.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createRatingHistory = `-- name: CreateRatingHistory :one
+INSERT INTO rating_history (
+    user_id,
+    game_id,
+    result,
+    rating_before,
+    rating_after,
+    rating_deviation_before,
+    rating_deviation_after,
+    rating_volatility_after
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, user_id, game_id, result, rating_before, rating_after, rating_deviation_before, rating_deviation_after, rating_volatility_after, created_at
+`
+
+type CreateRatingHistoryParams struct {
+	UserID                int64   `json:"user_id"`
+	GameID                int64   `json:"game_id"`
+	Result                string  `json:"result"`
+	RatingBefore          float64 `json:"rating_before"`
+	RatingAfter           float64 `json:"rating_after"`
+	RatingDeviationBefore float64 `json:"rating_deviation_before"`
+	RatingDeviationAfter  float64 `json:"rating_deviation_after"`
+	RatingVolatilityAfter float64 `json:"rating_volatility_after"`
+}
+
+func (q *Queries) CreateRatingHistory(ctx context.Context, arg CreateRatingHistoryParams) (RatingHistory, error) {
+	row := q.db.QueryRow(ctx, createRatingHistory,
+		arg.UserID,
+		arg.GameID,
+		arg.Result,
+		arg.RatingBefore,
+		arg.RatingAfter,
+		arg.RatingDeviationBefore,
+		arg.RatingDeviationAfter,
+		arg.RatingVolatilityAfter,
+	)
+	var i RatingHistory
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.GameID,
+		&i.Result,
+		&i.RatingBefore,
+		&i.RatingAfter,
+		&i.RatingDeviationBefore,
+		&i.RatingDeviationAfter,
+		&i.RatingVolatilityAfter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
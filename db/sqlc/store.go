@@ -9,6 +9,11 @@ import (
 
 type Store interface {
 	Querier
+	CreateGameTx(ctx context.Context, arg CreateGameTxParams) (CreateGameTxResult, error)
+	JoinGameTx(ctx context.Context, arg JoinGameTxParams) (JoinGameTxResult, error)
+	MakeMoveTx(ctx context.Context, arg MakeMoveTxParams) (MakeMoveTxResult, error)
+	EndGameTx(ctx context.Context, arg EndGameTxParams) (EndGameTxResult, error)
+	ImportGamesTx(ctx context.Context, arg ImportGamesTxParams) (ImportGamesTxResult, error)
 }
 
 type DBStore struct {
@@ -33,9 +38,9 @@ func (store *DBStore) execTx(ctx context.Context, fn func(*Queries) error) error
 	err = fn(q)
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
-			return fmt.Errorf("transaction error: %v, rollback error: %v", err, rbErr)
+			return fmt.Errorf("transaction error: %w, rollback error: %v", err, rbErr)
 		}
-		return fmt.Errorf("transaction error: %v", err)
+		return fmt.Errorf("transaction error: %w", err)
 	}
 
 	return tx.Commit(ctx)
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type CreateGameTxParams struct {
+	Code         string
+	HostUsername string
+	CurrentState string
+	Variant      string
+	Width        int32
+	Height       int32
+	WinLength    int32
+	Depth        int32
+	Rated        bool
+	Hints        bool
+}
+
+type CreateGameTxResult struct {
+	Game        Game
+	Participant GameParticipant
+}
+
+// CreateGameTx stores a new waiting game and registers its host as the first participant
+func (store *DBStore) CreateGameTx(ctx context.Context, arg CreateGameTxParams) (CreateGameTxResult, error) {
+	var result CreateGameTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		host, err := q.GetUser(ctx, arg.HostUsername)
+		if err != nil {
+			return err
+		}
+
+		hostID := pgtype.Int8{Int64: host.ID, Valid: true}
+		result.Game, err = q.CreateGame(ctx, CreateGameParams{
+			Code:           arg.Code,
+			HostUserID:     hostID,
+			Status:         GameStatusWaiting,
+			CurrentState:   pgtype.Text{String: arg.CurrentState, Valid: true},
+			NextTurnUserID: hostID,
+			Variant:        arg.Variant,
+			Width:          arg.Width,
+			Height:         arg.Height,
+			WinLength:      arg.WinLength,
+			Depth:          arg.Depth,
+			Rated:          arg.Rated,
+			Hints:          arg.Hints,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Participant, err = q.CreateGameParticipant(ctx, CreateGameParticipantParams{
+			GameID: pgtype.Int8{Int64: result.Game.ID, Valid: true},
+			UserID: hostID,
+		})
+		return err
+	})
+
+	return result, err
+}
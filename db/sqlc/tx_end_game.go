@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type EndGameTxParams struct {
+	Code             string
+	Reason           string
+	WinnerUsername   string // empty for a draw
+	ResignedUsername string // empty unless a player resigned
+}
+
+type EndGameTxResult struct {
+	Game Game
+}
+
+// EndGameTx finishes a game in progress that ended off the board, by
+// resignation or an agreed draw, and updates the ratings of both players if
+// the game is rated
+func (store *DBStore) EndGameTx(ctx context.Context, arg EndGameTxParams) (EndGameTxResult, error) {
+	var result EndGameTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		game, err := q.GetGameForUpdate(ctx, arg.Code)
+		if err != nil {
+			return err
+		}
+		if game.Status != GameStatusInProgress {
+			return fmt.Errorf("game %s is %s, not in progress", arg.Code, game.Status)
+		}
+
+		game, err = q.UpdateGame(ctx, UpdateGameParams{
+			ID:           game.ID,
+			Status:       GameStatusCompleted,
+			CurrentState: game.CurrentState,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Game, err = recordResult(ctx, q, game, arg.Reason, arg.WinnerUsername, arg.ResignedUsername)
+		return err
+	})
+
+	return result, err
+}
+
+// recordResult stores how a completed game ended and, if it is rated, applies
+// the result to the ratings of both players
+func recordResult(ctx context.Context, q *Queries, game Game, reason string, winnerUsername string, resignedUsername string) (Game, error) {
+	var winnerID, resignedID pgtype.Int8
+	if winnerUsername != "" {
+		winner, err := q.GetUser(ctx, winnerUsername)
+		if err != nil {
+			return game, err
+		}
+		winnerID = pgtype.Int8{Int64: winner.ID, Valid: true}
+	}
+	if resignedUsername != "" {
+		resigned, err := q.GetUser(ctx, resignedUsername)
+		if err != nil {
+			return game, err
+		}
+		resignedID = pgtype.Int8{Int64: resigned.ID, Valid: true}
+	}
+
+	finished, err := q.FinishGame(ctx, FinishGameParams{
+		ID:             game.ID,
+		WinnerUserID:   winnerID,
+		ResultReason:   pgtype.Text{String: reason, Valid: true},
+		ResignedUserID: resignedID,
+	})
+	if err != nil {
+		return game, err
+	}
+
+	// Games against bots are played for fun and leave ratings alone
+	if !finished.Rated {
+		return finished, nil
+	}
+	return finished, updateRatings(ctx, q, finished.ID, winnerID.Int64)
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ImportGamesTxParams struct {
+	Games []ImportedGame
+}
+
+// ImportedGame is a finished game loaded from outside the server
+type ImportedGame struct {
+	Code             string
+	Usernames        []string // in join order, the first is the host
+	WinnerUsername   string   // empty for a draw
+	ResultReason     string   // how the game ended, a GameReason value
+	ResignedUsername string   // empty unless a player resigned
+	CurrentState     string
+	Variant          string
+	Width            int32
+	Height           int32
+	WinLength        int32
+	Depth            int32
+	CreatedAt        time.Time
+	FinishedAt       time.Time
+	Moves            []ImportedMove
+}
+
+type ImportedMove struct {
+	Username string
+	Position int32
+	Symbol   string
+	PlayedAt time.Time
+}
+
+type ImportGamesTxResult struct {
+	Games []Game
+}
+
+// ImportGamesTx stores finished games together with their participants and
+// moves. Imported games are kept apart from played ones and never affect
+// ratings or statistics.
+func (store *DBStore) ImportGamesTx(ctx context.Context, arg ImportGamesTxParams) (ImportGamesTxResult, error) {
+	var result ImportGamesTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		userIDs := make(map[string]int64)
+		for _, imported := range arg.Games {
+			for _, username := range imported.Usernames {
+				if _, ok := userIDs[username]; ok {
+					continue
+				}
+				user, err := q.GetUser(ctx, username)
+				if err != nil {
+					return err
+				}
+				userIDs[username] = user.ID
+			}
+
+			var winnerID pgtype.Int8
+			if imported.WinnerUsername != "" {
+				winnerID = pgtype.Int8{Int64: userIDs[imported.WinnerUsername], Valid: true}
+			}
+
+			var resignedID pgtype.Int8
+			if imported.ResignedUsername != "" {
+				resignedID = pgtype.Int8{Int64: userIDs[imported.ResignedUsername], Valid: true}
+			}
+
+			game, err := q.ImportGame(ctx, ImportGameParams{
+				Code:           imported.Code,
+				HostUserID:     pgtype.Int8{Int64: userIDs[imported.Usernames[0]], Valid: true},
+				Status:         GameStatusImported,
+				CurrentState:   pgtype.Text{String: imported.CurrentState, Valid: true},
+				WinnerUserID:   winnerID,
+				CreatedAt:      imported.CreatedAt,
+				FinishedAt:     pgtype.Timestamptz{Time: imported.FinishedAt, Valid: true},
+				Variant:        imported.Variant,
+				Width:          imported.Width,
+				Height:         imported.Height,
+				WinLength:      imported.WinLength,
+				Depth:          imported.Depth,
+				ResultReason:   pgtype.Text{String: imported.ResultReason, Valid: true},
+				ResignedUserID: resignedID,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, username := range imported.Usernames {
+				_, err = q.CreateGameParticipant(ctx, CreateGameParticipantParams{
+					GameID: pgtype.Int8{Int64: game.ID, Valid: true},
+					UserID: pgtype.Int8{Int64: userIDs[username], Valid: true},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			for i, move := range imported.Moves {
+				_, err = q.ImportGameMove(ctx, ImportGameMoveParams{
+					GameID:     game.ID,
+					MoveNumber: int32(i + 1),
+					UserID:     userIDs[move.Username],
+					Position:   move.Position,
+					Symbol:     move.Symbol,
+					CreatedAt:  move.PlayedAt,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			result.Games = append(result.Games, game)
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type JoinGameTxParams struct {
+	Code     string
+	Username string
+}
+
+type JoinGameTxResult struct {
+	Game        Game
+	Participant GameParticipant
+}
+
+// JoinGameTx adds a second participant to a waiting game and marks it as in progress
+func (store *DBStore) JoinGameTx(ctx context.Context, arg JoinGameTxParams) (JoinGameTxResult, error) {
+	var result JoinGameTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		game, err := q.GetGameForUpdate(ctx, arg.Code)
+		if err != nil {
+			return err
+		}
+		if game.Status != GameStatusWaiting {
+			return ErrGameNotWaiting
+		}
+
+		user, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		result.Participant, err = q.CreateGameParticipant(ctx, CreateGameParticipantParams{
+			GameID: pgtype.Int8{Int64: game.ID, Valid: true},
+			UserID: pgtype.Int8{Int64: user.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Game, err = q.UpdateGame(ctx, UpdateGameParams{
+			ID:             game.ID,
+			Status:         GameStatusInProgress,
+			CurrentState:   game.CurrentState,
+			NextTurnUserID: game.NextTurnUserID,
+		})
+		return err
+	})
+
+	return result, err
+}
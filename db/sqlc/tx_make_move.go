@@ -0,0 +1,177 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"main/rating"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type MakeMoveTxParams struct {
+	Code             string
+	Username         string
+	Position         int32
+	Symbol           string
+	Status           string
+	CurrentState     string
+	NextTurnUsername string // empty once the game is over
+	WinnerUsername   string // empty unless the move decided the game
+}
+
+type MakeMoveTxResult struct {
+	Game Game
+	Move GameMove
+}
+
+// MakeMoveTx appends a move to the game's move log and stores the resulting
+// board, status and next player. A move that finishes the game also records
+// its outcome and, if the game is rated, updates the ratings of both players.
+func (store *DBStore) MakeMoveTx(ctx context.Context, arg MakeMoveTxParams) (MakeMoveTxResult, error) {
+	var result MakeMoveTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		game, err := q.GetGameForUpdate(ctx, arg.Code)
+		if err != nil {
+			return err
+		}
+
+		player, err := q.GetUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		moveCount, err := q.CountGameMoves(ctx, game.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Move, err = q.CreateGameMove(ctx, CreateGameMoveParams{
+			GameID:     game.ID,
+			MoveNumber: int32(moveCount) + 1,
+			UserID:     player.ID,
+			Position:   arg.Position,
+			Symbol:     arg.Symbol,
+		})
+		if err != nil {
+			return err
+		}
+
+		var nextTurnUserID pgtype.Int8
+		if arg.NextTurnUsername != "" {
+			nextTurn, err := q.GetUser(ctx, arg.NextTurnUsername)
+			if err != nil {
+				return err
+			}
+			nextTurnUserID = pgtype.Int8{Int64: nextTurn.ID, Valid: true}
+		}
+
+		result.Game, err = q.UpdateGame(ctx, UpdateGameParams{
+			ID:             game.ID,
+			Status:         arg.Status,
+			CurrentState:   pgtype.Text{String: arg.CurrentState, Valid: true},
+			NextTurnUserID: nextTurnUserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Status != GameStatusCompleted {
+			return nil
+		}
+
+		// In some variants the final move loses, so the winner is passed in
+		// rather than assumed to be the player who moved
+		result.Game, err = recordResult(ctx, q, result.Game, GameReasonPlayedOut, arg.WinnerUsername, "")
+		return err
+	})
+
+	return result, err
+}
+
+// updateRatings applies the result of a finished game to its two players'
+// Glicko-2 ratings and records the change in their rating history; a zero
+// winnerID means the game was drawn
+func updateRatings(ctx context.Context, q *Queries, gameID int64, winnerID int64) error {
+	participants, err := q.ListGameParticipants(ctx, pgtype.Int8{Int64: gameID, Valid: true})
+	if err != nil {
+		return err
+	}
+	if len(participants) != 2 {
+		return fmt.Errorf("game %d has %d participants, expected 2", gameID, len(participants))
+	}
+
+	// Lock players in id order so concurrent games cannot deadlock
+	idA, idB := participants[0].UserID.Int64, participants[1].UserID.Int64
+	if idA > idB {
+		idA, idB = idB, idA
+	}
+
+	playerA, err := q.GetUserByIDForUpdate(ctx, idA)
+	if err != nil {
+		return err
+	}
+	playerB, err := q.GetUserByIDForUpdate(ctx, idB)
+	if err != nil {
+		return err
+	}
+
+	scoreA := rating.Draw
+	switch winnerID {
+	case 0:
+	case playerA.ID:
+		scoreA = rating.Win
+	case playerB.ID:
+		scoreA = rating.Loss
+	default:
+		return fmt.Errorf("winner %d did not play game %d", winnerID, gameID)
+	}
+
+	ratingA, ratingB := rating.Glicko2(userRating(playerA), userRating(playerB), scoreA)
+
+	if err := applyRating(ctx, q, gameID, playerA, ratingA, scoreA); err != nil {
+		return err
+	}
+	return applyRating(ctx, q, gameID, playerB, ratingB, 1-scoreA)
+}
+
+// applyRating stores a player's new rating together with a history entry
+func applyRating(ctx context.Context, q *Queries, gameID int64, user User, updated rating.Player, score float64) error {
+	result := GameResultDraw
+	switch score {
+	case rating.Win:
+		result = GameResultWin
+	case rating.Loss:
+		result = GameResultLoss
+	}
+
+	_, err := q.CreateRatingHistory(ctx, CreateRatingHistoryParams{
+		UserID:                user.ID,
+		GameID:                gameID,
+		Result:                result,
+		RatingBefore:          user.Rating,
+		RatingAfter:           updated.Rating,
+		RatingDeviationBefore: user.RatingDeviation,
+		RatingDeviationAfter:  updated.Deviation,
+		RatingVolatilityAfter: updated.Volatility,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = q.UpdateUserRating(ctx, UpdateUserRatingParams{
+		ID:               user.ID,
+		Rating:           updated.Rating,
+		RatingDeviation:  updated.Deviation,
+		RatingVolatility: updated.Volatility,
+	})
+	return err
+}
+
+func userRating(user User) rating.Player {
+	return rating.Player{
+		Rating:     user.Rating,
+		Deviation:  user.RatingDeviation,
+		Volatility: user.RatingVolatility,
+	}
+}
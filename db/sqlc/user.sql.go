@@ -10,7 +10,7 @@ import (
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, password_hash) VALUES ($1, $2) RETURNING id, username, password_hash, created_at
+INSERT INTO users (username, password_hash) VALUES ($1, $2) RETURNING id, username, password_hash, created_at, rating, rating_deviation, rating_volatility
 `
 
 type CreateUserParams struct {
@@ -26,12 +26,15 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Username,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.Rating,
+		&i.RatingDeviation,
+		&i.RatingVolatility,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, password_hash, created_at FROM users WHERE username = $1 LIMIT 1
+SELECT id, username, password_hash, created_at, rating, rating_deviation, rating_volatility FROM users WHERE username = $1 LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
@@ -42,6 +45,59 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.Username,
 		&i.PasswordHash,
 		&i.CreatedAt,
+		&i.Rating,
+		&i.RatingDeviation,
+		&i.RatingVolatility,
+	)
+	return i, err
+}
+
+const getUserByIDForUpdate = `-- name: GetUserByIDForUpdate :one
+SELECT id, username, password_hash, created_at, rating, rating_deviation, rating_volatility FROM users WHERE id = $1 LIMIT 1 FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetUserByIDForUpdate(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByIDForUpdate, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.Rating,
+		&i.RatingDeviation,
+		&i.RatingVolatility,
+	)
+	return i, err
+}
+
+const updateUserRating = `-- name: UpdateUserRating :one
+UPDATE users SET rating = $2, rating_deviation = $3, rating_volatility = $4 WHERE id = $1 RETURNING id, username, password_hash, created_at, rating, rating_deviation, rating_volatility
+`
+
+type UpdateUserRatingParams struct {
+	ID               int64   `json:"id"`
+	Rating           float64 `json:"rating"`
+	RatingDeviation  float64 `json:"rating_deviation"`
+	RatingVolatility float64 `json:"rating_volatility"`
+}
+
+func (q *Queries) UpdateUserRating(ctx context.Context, arg UpdateUserRatingParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRating,
+		arg.ID,
+		arg.Rating,
+		arg.RatingDeviation,
+		arg.RatingVolatility,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.Rating,
+		&i.RatingDeviation,
+		&i.RatingVolatility,
 	)
 	return i, err
 }
@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: user_stats.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getHeadToHead = `-- name: GetHeadToHead :one
+SELECT
+    count(*) AS games_played,
+    count(*) FILTER (WHERE g.winner_user_id = me.user_id) AS wins,
+    count(*) FILTER (WHERE g.winner_user_id = op.user_id) AS losses,
+    count(*) FILTER (WHERE g.winner_user_id IS NULL) AS draws
+FROM games g
+JOIN game_participants me ON me.game_id = g.id AND me.user_id = $1::bigint
+JOIN game_participants op ON op.game_id = g.id AND op.user_id = $2::bigint
+WHERE g.status = 'completed'
+`
+
+type GetHeadToHeadParams struct {
+	UserID     int64 `json:"user_id"`
+	OpponentID int64 `json:"opponent_id"`
+}
+
+type GetHeadToHeadRow struct {
+	GamesPlayed int64 `json:"games_played"`
+	Wins        int64 `json:"wins"`
+	Losses      int64 `json:"losses"`
+	Draws       int64 `json:"draws"`
+}
+
+func (q *Queries) GetHeadToHead(ctx context.Context, arg GetHeadToHeadParams) (GetHeadToHeadRow, error) {
+	row := q.db.QueryRow(ctx, getHeadToHead, arg.UserID, arg.OpponentID)
+	var i GetHeadToHeadRow
+	err := row.Scan(
+		&i.GamesPlayed,
+		&i.Wins,
+		&i.Losses,
+		&i.Draws,
+	)
+	return i, err
+}
+
+const getUserStats = `-- name: GetUserStats :one
+SELECT
+    count(*) AS games_played,
+    count(*) FILTER (WHERE g.winner_user_id = gp.user_id) AS wins,
+    count(*) FILTER (WHERE g.winner_user_id <> gp.user_id) AS losses,
+    count(*) FILTER (WHERE g.winner_user_id IS NULL) AS draws
+FROM games g
+JOIN game_participants gp ON gp.game_id = g.id
+WHERE gp.user_id = $1::bigint
+    AND g.status = 'completed'
+`
+
+type GetUserStatsRow struct {
+	GamesPlayed int64 `json:"games_played"`
+	Wins        int64 `json:"wins"`
+	Losses      int64 `json:"losses"`
+	Draws       int64 `json:"draws"`
+}
+
+func (q *Queries) GetUserStats(ctx context.Context, userID int64) (GetUserStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserStats, userID)
+	var i GetUserStatsRow
+	err := row.Scan(
+		&i.GamesPlayed,
+		&i.Wins,
+		&i.Losses,
+		&i.Draws,
+	)
+	return i, err
+}
+
+const listUserGames = `-- name: ListUserGames :many
+SELECT id, code, created_at, finished_at, opponent_username, result FROM (
+    SELECT
+        g.id,
+        g.code,
+        g.created_at,
+        g.finished_at,
+        o.username AS opponent_username,
+        (CASE
+            WHEN g.winner_user_id IS NULL THEN 'draw'
+            WHEN g.winner_user_id = me.user_id THEN 'win'
+            ELSE 'loss'
+        END)::varchar AS result
+    FROM games g
+    JOIN game_participants me ON me.game_id = g.id AND me.user_id = $1::bigint
+    JOIN game_participants op ON op.game_id = g.id AND op.user_id <> me.user_id
+    JOIN users o ON o.id = op.user_id
+    WHERE g.status = 'completed'
+        AND g.id < $2::bigint
+        AND g.finished_at >= $3::timestamptz
+        AND g.finished_at < $4::timestamptz
+        AND ($5::varchar = '' OR o.username = $5::varchar)
+) user_games
+WHERE $6::varchar = '' OR user_games.result = $6::varchar
+ORDER BY user_games.id DESC
+LIMIT $7
+`
+
+type ListUserGamesParams struct {
+	UserID         int64     `json:"user_id"`
+	BeforeID       int64     `json:"before_id"`
+	FinishedAfter  time.Time `json:"finished_after"`
+	FinishedBefore time.Time `json:"finished_before"`
+	Opponent       string    `json:"opponent"`
+	Result         string    `json:"result"`
+	LimitCount     int32     `json:"limit_count"`
+}
+
+type ListUserGamesRow struct {
+	ID               int64              `json:"id"`
+	Code             string             `json:"code"`
+	CreatedAt        time.Time          `json:"created_at"`
+	FinishedAt       pgtype.Timestamptz `json:"finished_at"`
+	OpponentUsername string             `json:"opponent_username"`
+	Result           string             `json:"result"`
+}
+
+func (q *Queries) ListUserGames(ctx context.Context, arg ListUserGamesParams) ([]ListUserGamesRow, error) {
+	rows, err := q.db.Query(ctx, listUserGames,
+		arg.UserID,
+		arg.BeforeID,
+		arg.FinishedAfter,
+		arg.FinishedBefore,
+		arg.Opponent,
+		arg.Result,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserGamesRow{}
+	for rows.Next() {
+		var i ListUserGamesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.CreatedAt,
+			&i.FinishedAt,
+			&i.OpponentUsername,
+			&i.Result,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"main/token"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader = "authorization"
+)
+
+// authorizeUser verifies the bearer token sent in the request metadata
+func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("missing metadata")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing authorization header")
+	}
+
+	return server.tokenMaker.AuthenticateUser(values[0])
+}
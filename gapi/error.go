@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"context"
+	"errors"
+	"main/ws"
+
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,6 +29,41 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 	return statusDetails.Err()
 }
 
-// func unathorizedRequest(err error) error {
-// 	return status.Errorf(codes.Unauthenticated, "unauthorized %s", err)
-// }
+func unauthenticatedError(err error) error {
+	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
+}
+
+// gameError converts an error returned by the game manager into a gRPC status
+func gameError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	var gameErr *ws.GameError
+	if !errors.As(err, &gameErr) {
+		return status.Errorf(codes.Internal, "game error: %s", err)
+	}
+
+	code := codes.FailedPrecondition
+	switch gameErr.Code {
+	case ws.ErrGameNotFound, ws.ErrNotQueued, ws.ErrUserNotFound:
+		code = codes.NotFound
+	case ws.ErrGameExists, ws.ErrAlreadyQueued:
+		code = codes.AlreadyExists
+	case ws.ErrMatchTimeout:
+		code = codes.DeadlineExceeded
+	case ws.ErrMatchCancelled:
+		code = codes.Canceled
+	case ws.ErrInvalidMove, ws.ErrInvalidNotation, ws.ErrInvalidColumn, ws.ErrColumnRequired, ws.ErrPositionRequired,
+		ws.ErrQuantumRequired, ws.ErrSpookyPair, ws.ErrInvalidCollapse, ws.ErrInvalidBotLevel, ws.ErrInvalidGameID:
+		code = codes.InvalidArgument
+	case ws.ErrNotPlayer:
+		code = codes.PermissionDenied
+	case ws.ErrAnalysisBusy:
+		code = codes.ResourceExhausted
+	case ws.ErrInternal:
+		code = codes.Internal
+	}
+
+	return status.Error(code, gameErr.Error())
+}
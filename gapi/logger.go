@@ -40,3 +40,33 @@ func GrpcLogger(
 
 	return result, err
 }
+
+func GrpcStreamLogger(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	startTime := time.Now()
+	err := handler(srv, stream)
+	duration := time.Since(startTime)
+
+	statusCode := codes.Unknown
+	if status, ok := status.FromError(err); ok {
+		statusCode = status.Code()
+	}
+
+	logger := log.Info()
+	if err != nil {
+		logger = log.Error().Err(err)
+	}
+
+	logger.
+		Str("method", info.FullMethod).
+		Int("status_code", int(statusCode)).
+		Str("status_text", statusCode.String()).
+		Dur("duration", duration).
+		Msg("received a gRPC stream")
+
+	return err
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) AnalyzeGame(ctx context.Context, req *pb.AnalyzeGameRequest) (*pb.AnalyzeGameResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAnalyzeGameRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	analysis, err := server.wsManager.AnalyzeGame(ctx, req.GetGameId(), payload.Username)
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	response := &pb.AnalyzeGameResponse{
+		Analysis: utils.ConvertGameAnalysis(analysis),
+	}
+	return response, nil
+}
+
+func validateAnalyzeGameRequest(req *pb.AnalyzeGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,19 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+)
+
+func (server *Server) CancelMatch(ctx context.Context, req *pb.CancelMatchRequest) (*pb.CancelMatchResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if err := server.matchmaker.CancelMatch(payload.Username); err != nil {
+		return nil, gameError(err)
+	}
+
+	return &pb.CancelMatchResponse{}, nil
+}
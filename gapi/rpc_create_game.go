@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"main/bot"
+	"main/pb"
+	"main/rules"
+	"main/utils"
+	"main/ws"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateGameRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	gameID := req.GetGameId()
+	if gameID == "" {
+		gameID = uuid.NewString()
+	}
+
+	settings := gameSettings(req.GetSettings())
+	hints := !req.GetDisableHints()
+	if req.GetBot() != "" {
+		err = server.wsManager.CreateBotGame(ctx, gameID, payload.Username, settings, bot.Level(req.GetBot()), hints)
+	} else {
+		options := ws.GameOptions{Rated: !req.GetCasual(), Hints: req.GetCasual() && hints}
+		err = server.wsManager.CreateGame(ctx, gameID, payload.Username, settings, options)
+	}
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	game, err := server.wsManager.GetGame(gameID)
+	if err != nil {
+		return nil, gameError(err)
+	}
+	server.wsManager.BroadcastGameState(gameID)
+
+	response := &pb.CreateGameResponse{
+		Game: utils.ConvertGame(gameID, game),
+	}
+	return response, nil
+}
+
+func validateCreateGameRequest(req *pb.CreateGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	// An empty game id asks the server to generate one
+	if req.GetGameId() != "" {
+		if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+			violations = append(violations, fieldViolation("game_id", err))
+		}
+	}
+	if err := gameSettings(req.GetSettings()).Validate(); err != nil {
+		violations = append(violations, fieldViolation("settings", err))
+	}
+	if req.GetBot() != "" && !bot.Level(req.GetBot()).Valid() {
+		violations = append(violations, fieldViolation("bot", fmt.Errorf("must be one of %v", bot.Levels)))
+	}
+	return violations
+}
+
+// gameSettings returns the requested variant and board settings; unset
+// fields keep the defaults of the variant, which is standard 3x3 unless given
+func gameSettings(settings *pb.GameSettings) rules.Settings {
+	result := rules.Standard
+	if settings == nil {
+		return result
+	}
+
+	if settings.GetVariant() != "" {
+		result = rules.DefaultSettings(settings.GetVariant())
+		result.Variant = settings.GetVariant()
+	}
+	if settings.GetWidth() != 0 {
+		result.Width = int(settings.GetWidth())
+	}
+	if settings.GetHeight() != 0 {
+		result.Height = int(settings.GetHeight())
+	}
+	if settings.GetWinLength() != 0 {
+		result.WinLength = int(settings.GetWinLength())
+	}
+	if settings.GetDepth() != 0 {
+		result.Depth = int(settings.GetDepth())
+	}
+	return result
+}
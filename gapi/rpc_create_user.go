@@ -2,8 +2,11 @@ package gapi
 
 import (
 	"context"
+	"fmt"
+	"main/bot"
 	db "main/db/sqlc"
 	"main/pb"
+	"strings"
 
 	utils "main/utils"
 
@@ -40,7 +43,7 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 }
 
 func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := utils.ValidateUsername(req.GetUsername()); err != nil {
+	if err := validateNewUsername(req.GetUsername()); err != nil {
 		violations = append(violations, fieldViolation("username", err))
 	}
 	if err := utils.ValidatePassword(req.GetPassword()); err != nil {
@@ -48,3 +51,15 @@ func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdeta
 	}
 	return violations
 }
+
+// validateNewUsername checks the username of an account being created, which
+// must not claim a name reserved for bots
+func validateNewUsername(username string) error {
+	if err := utils.ValidateUsername(username); err != nil {
+		return err
+	}
+	if strings.HasPrefix(username, bot.UsernamePrefix) {
+		return fmt.Errorf("usernames starting with %s are reserved for bots", bot.UsernamePrefix)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ExportGame(ctx context.Context, req *pb.ExportGameRequest) (*pb.ExportGameResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateExportGameRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	text, err := server.wsManager.ExportGame(ctx, req.GetGameId())
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	response := &pb.ExportGameResponse{
+		Notation: text,
+	}
+	return response, nil
+}
+
+func validateExportGameRequest(req *pb.ExportGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
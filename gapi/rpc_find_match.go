@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) FindMatch(ctx context.Context, req *pb.FindMatchRequest) (*pb.FindMatchResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	ticket, err := server.matchmaker.FindMatch(ctx, payload.Username)
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	select {
+	case <-ctx.Done():
+		// The caller gave up, so leave the queue, or abandon the game if a
+		// match was made meanwhile
+		server.matchmaker.LeaveMatch(context.WithoutCancel(ctx), ticket)
+		return nil, status.FromContextError(ctx.Err()).Err()
+
+	case result := <-ticket.Result():
+		if result.Err != nil {
+			return nil, gameError(result.Err)
+		}
+
+		game, err := server.wsManager.GetGame(result.GameID)
+		if err != nil {
+			return nil, gameError(err)
+		}
+
+		response := &pb.FindMatchResponse{
+			Game:     utils.ConvertGame(result.GameID, game),
+			Opponent: result.Opponent,
+		}
+		return response, nil
+	}
+}
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetGame(ctx context.Context, req *pb.GetGameRequest) (*pb.GetGameResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetGameRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	game, err := server.wsManager.GetGame(req.GetGameId())
+	if err != nil {
+		// Finished games are no longer live, rebuild them from the move log
+		game, err = server.wsManager.RebuildGame(ctx, req.GetGameId())
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "game not found: %s", err)
+			}
+			return nil, status.Errorf(codes.Internal, "cannot get game: %s", err)
+		}
+	}
+
+	response := &pb.GetGameResponse{
+		Game: utils.ConvertGame(req.GetGameId(), game),
+	}
+	return response, nil
+}
+
+func validateGetGameRequest(req *pb.GetGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+	"main/ws"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetGameParticipants(ctx context.Context, req *pb.GetGameParticipantsRequest) (*pb.GetGameParticipantsResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetGameParticipantsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	game, err := server.store.GetGame(ctx, req.GetGameId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "game not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "cannot get game: %s", err)
+	}
+
+	usernames, err := server.store.ListGameParticipantUsernames(ctx, pgtype.Int8{Int64: game.ID, Valid: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list participants: %s", err)
+	}
+
+	// Participants are listed in join order, which decides their symbols
+	response := &pb.GetGameParticipantsResponse{
+		Participants: make([]*pb.GameParticipant, len(usernames)),
+	}
+	for seat, username := range usernames {
+		response.Participants[seat] = &pb.GameParticipant{
+			Username: username,
+			Symbol:   ws.SeatSymbol(seat),
+			Host:     seat == 0,
+		}
+	}
+	return response, nil
+}
+
+func validateGetGameParticipantsRequest(req *pb.GetGameParticipantsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetGameReplay(ctx context.Context, req *pb.GetGameReplayRequest) (*pb.GetGameReplayResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetGameReplayRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	replay, err := server.wsManager.LoadReplay(ctx, req.GetGameId())
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	response := &pb.GetGameReplayResponse{
+		Replay: utils.ConvertGameReplay(replay),
+	}
+	return response, nil
+}
+
+func validateGetGameReplayRequest(req *pb.GetGameReplayRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetHeadToHead(ctx context.Context, req *pb.GetHeadToHeadRequest) (*pb.GetHeadToHeadResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	username := req.GetUsername()
+	if username == "" {
+		username = payload.Username
+	}
+
+	violations := validateGetHeadToHeadRequest(username, req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	opponent, err := server.getUser(ctx, req.GetOpponent())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := server.store.GetHeadToHead(ctx, db.GetHeadToHeadParams{
+		UserID:     user.ID,
+		OpponentID: opponent.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get head-to-head record: %s", err)
+	}
+
+	response := &pb.GetHeadToHeadResponse{
+		Username:    user.Username,
+		Opponent:    opponent.Username,
+		GamesPlayed: record.GamesPlayed,
+		Wins:        record.Wins,
+		Losses:      record.Losses,
+		Draws:       record.Draws,
+	}
+	return response, nil
+}
+
+func validateGetHeadToHeadRequest(username string, req *pb.GetHeadToHeadRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateUsername(username); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+	if err := utils.ValidateUsername(req.GetOpponent()); err != nil {
+		violations = append(violations, fieldViolation("opponent", err))
+	} else if req.GetOpponent() == username {
+		violations = append(violations, fieldViolation("opponent", fmt.Errorf("must differ from username")))
+	}
+	return violations
+}
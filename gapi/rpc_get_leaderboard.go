@@ -0,0 +1,121 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxLeaderboardUsernames = 100
+
+func (server *Server) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetLeaderboardRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	usernames := req.GetUsernames()
+	if len(usernames) > 0 {
+		usernames = append(usernames, payload.Username)
+	}
+	since := leaderboardWindowStart(req.GetWindow(), time.Now())
+	orderBy := leaderboardOrder(req.GetOrderBy())
+
+	entries, err := server.store.ListLeaderboard(ctx, db.ListLeaderboardParams{
+		Since:       since,
+		Usernames:   usernames,
+		OrderBy:     orderBy,
+		LimitCount:  req.GetPageSize(),
+		OffsetCount: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list leaderboard: %s", err)
+	}
+
+	totalCount, err := server.store.CountLeaderboard(ctx, db.CountLeaderboardParams{
+		Since:     since,
+		Usernames: usernames,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot count leaderboard: %s", err)
+	}
+
+	response := &pb.GetLeaderboardResponse{
+		Entries:    make([]*pb.LeaderboardEntry, len(entries)),
+		TotalCount: totalCount,
+	}
+	for i, entry := range entries {
+		response.Entries[i] = utils.ConvertLeaderboardEntry(entry)
+	}
+
+	myEntry, err := server.store.GetLeaderboardEntry(ctx, db.GetLeaderboardEntryParams{
+		Username:  payload.Username,
+		Since:     since,
+		Usernames: usernames,
+		OrderBy:   orderBy,
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "cannot get leaderboard rank: %s", err)
+	}
+	if err == nil {
+		response.MyEntry = utils.ConvertLeaderboardEntry(db.ListLeaderboardRow(myEntry))
+	}
+
+	return response, nil
+}
+
+// leaderboardWindowStart returns the earliest game time counted in a window;
+// months and weeks (starting Monday) follow the UTC calendar
+func leaderboardWindowStart(window pb.LeaderboardWindow, now time.Time) time.Time {
+	now = now.UTC()
+	switch window {
+	case pb.LeaderboardWindow_LEADERBOARD_WINDOW_MONTH:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case pb.LeaderboardWindow_LEADERBOARD_WINDOW_WEEK:
+		daysSinceMonday := (int(now.Weekday()) + 6) % 7
+		return time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Unix(0, 0).UTC()
+}
+
+func leaderboardOrder(order pb.LeaderboardOrder) string {
+	switch order {
+	case pb.LeaderboardOrder_LEADERBOARD_ORDER_WINS:
+		return db.LeaderboardOrderWins
+	case pb.LeaderboardOrder_LEADERBOARD_ORDER_WIN_RATE:
+		return db.LeaderboardOrderWinRate
+	}
+	return db.LeaderboardOrderRating
+}
+
+func validateGetLeaderboardRequest(req *pb.GetLeaderboardRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateNumber(req.GetPageId(), 1, 10000); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := utils.ValidateNumber(req.GetPageSize(), 1, 50); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if len(req.GetUsernames()) > maxLeaderboardUsernames {
+		violations = append(violations, fieldViolation("usernames", fmt.Errorf("at most %d usernames allowed", maxLeaderboardUsernames)))
+	}
+	for _, username := range req.GetUsernames() {
+		if err := utils.ValidateUsername(username); err != nil {
+			violations = append(violations, fieldViolation("usernames", err))
+			break
+		}
+	}
+	return violations
+}
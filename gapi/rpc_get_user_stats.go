@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.GetUserStatsResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetUserStatsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	username := req.GetUsername()
+	if username == "" {
+		username = payload.Username
+	}
+
+	user, err := server.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := server.store.GetUserStats(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get user stats: %s", err)
+	}
+
+	response := &pb.GetUserStatsResponse{
+		Stats: utils.ConvertUserStats(user.Username, stats),
+	}
+	return response, nil
+}
+
+func validateGetUserStatsRequest(req *pb.GetUserStatsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetUsername() != "" {
+		if err := utils.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, fieldViolation("username", err))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"main/notation"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxImportGames caps the number of games loaded by a single request
+const maxImportGames = 100
+
+func (server *Server) ImportGames(ctx context.Context, req *pb.ImportGamesRequest) (*pb.ImportGamesResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	games, err := notation.Parse(req.GetNotation())
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("notation", err)})
+	}
+
+	violations := validateImportedGames(games)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// Players may only import their own games, not write history for others
+	for i, game := range games {
+		if game.PlayerX != payload.Username && game.PlayerO != payload.Username {
+			return nil, status.Errorf(codes.PermissionDenied, "game %d: cannot import a game %s did not play", i+1, payload.Username)
+		}
+	}
+
+	gameIDs, err := server.wsManager.ImportGames(ctx, games)
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	response := &pb.ImportGamesResponse{
+		GameIds: gameIDs,
+	}
+	return response, nil
+}
+
+func validateImportedGames(games []*notation.Game) (violations []*errdetails.BadRequest_FieldViolation) {
+	if len(games) == 0 || len(games) > maxImportGames {
+		violations = append(violations, fieldViolation("notation", fmt.Errorf("must contain between 1 and %d games", maxImportGames)))
+		return violations
+	}
+
+	for i, game := range games {
+		if game.GameID != "" {
+			if err := utils.ValidateGameID(game.GameID); err != nil {
+				violations = append(violations, fieldViolation("notation", fmt.Errorf("game %d: %w", i+1, err)))
+			}
+		}
+		if err := utils.ValidateUsername(game.PlayerX); err != nil {
+			violations = append(violations, fieldViolation("notation", fmt.Errorf("game %d: X: %w", i+1, err)))
+		}
+		if err := utils.ValidateUsername(game.PlayerO); err != nil {
+			violations = append(violations, fieldViolation("notation", fmt.Errorf("game %d: O: %w", i+1, err)))
+		}
+	}
+	return violations
+}
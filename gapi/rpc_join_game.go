@@ -0,0 +1,43 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) JoinGame(ctx context.Context, req *pb.JoinGameRequest) (*pb.JoinGameResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateJoinGameRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.wsManager.JoinGame(ctx, req.GetGameId(), payload.Username); err != nil {
+		return nil, gameError(err)
+	}
+
+	game, err := server.wsManager.GetGame(req.GetGameId())
+	if err != nil {
+		return nil, gameError(err)
+	}
+	server.wsManager.BroadcastGameState(req.GetGameId())
+
+	response := &pb.JoinGameResponse{
+		Game: utils.ConvertGame(req.GetGameId(), game),
+	}
+	return response, nil
+}
+
+func validateJoinGameRequest(req *pb.JoinGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAvailableGames(ctx context.Context, req *pb.ListAvailableGamesRequest) (*pb.ListAvailableGamesResponse, error) {
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListAvailableGamesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	games, err := server.store.ListAvailableGames(ctx, db.ListAvailableGamesParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list available games: %s", err)
+	}
+
+	totalCount, err := server.store.CountAvailableGames(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot count available games: %s", err)
+	}
+
+	response := &pb.ListAvailableGamesResponse{
+		Games:      make([]*pb.GameSummary, len(games)),
+		TotalCount: totalCount,
+	}
+	for i, game := range games {
+		response.Games[i] = utils.ConvertGameSummary(game)
+	}
+	return response, nil
+}
+
+func validateListAvailableGamesRequest(req *pb.ListAvailableGamesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateNumber(req.GetPageId(), 1, 10000); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+	if err := utils.ValidateNumber(req.GetPageSize(), 1, 50); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	return violations
+}
@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/utils"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListUserGames(ctx context.Context, req *pb.ListUserGamesRequest) (*pb.ListUserGamesResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListUserGamesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	username := req.GetUsername()
+	if username == "" {
+		username = payload.Username
+	}
+
+	user, err := server.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Games are listed newest first; the page token is the id of the last game returned
+	beforeID := int64(math.MaxInt64)
+	if req.GetPageToken() != "" {
+		beforeID, _ = strconv.ParseInt(req.GetPageToken(), 10, 64)
+	}
+
+	finishedAfter := time.Unix(0, 0)
+	if req.GetFinishedAfter() != nil {
+		finishedAfter = req.GetFinishedAfter().AsTime()
+	}
+	finishedBefore := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if req.GetFinishedBefore() != nil {
+		finishedBefore = req.GetFinishedBefore().AsTime()
+	}
+
+	games, err := server.store.ListUserGames(ctx, db.ListUserGamesParams{
+		UserID:         user.ID,
+		BeforeID:       beforeID,
+		FinishedAfter:  finishedAfter,
+		FinishedBefore: finishedBefore,
+		Opponent:       req.GetOpponent(),
+		Result:         gameResultFilter(req.GetResult()),
+		LimitCount:     req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list user games: %s", err)
+	}
+
+	response := &pb.ListUserGamesResponse{
+		Games: make([]*pb.UserGame, len(games)),
+	}
+	for i, game := range games {
+		response.Games[i] = utils.ConvertUserGame(game)
+	}
+	if len(games) == int(req.GetPageSize()) {
+		response.NextPageToken = strconv.FormatInt(games[len(games)-1].ID, 10)
+	}
+	return response, nil
+}
+
+func gameResultFilter(result pb.GameResult) string {
+	switch result {
+	case pb.GameResult_GAME_RESULT_WIN:
+		return db.GameResultWin
+	case pb.GameResult_GAME_RESULT_LOSS:
+		return db.GameResultLoss
+	case pb.GameResult_GAME_RESULT_DRAW:
+		return db.GameResultDraw
+	}
+	return ""
+}
+
+func validateListUserGamesRequest(req *pb.ListUserGamesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetUsername() != "" {
+		if err := utils.ValidateUsername(req.GetUsername()); err != nil {
+			violations = append(violations, fieldViolation("username", err))
+		}
+	}
+	if err := utils.ValidateNumber(req.GetPageSize(), 1, 50); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+	if req.GetPageToken() != "" {
+		if id, err := strconv.ParseInt(req.GetPageToken(), 10, 64); err != nil || id <= 0 {
+			violations = append(violations, fieldViolation("page_token", fmt.Errorf("invalid page token")))
+		}
+	}
+	if req.GetOpponent() != "" {
+		if err := utils.ValidateUsername(req.GetOpponent()); err != nil {
+			violations = append(violations, fieldViolation("opponent", err))
+		}
+	}
+	if req.GetFinishedAfter() != nil && req.GetFinishedBefore() != nil &&
+		!req.GetFinishedAfter().AsTime().Before(req.GetFinishedBefore().AsTime()) {
+		violations = append(violations, fieldViolation("finished_before", fmt.Errorf("must be after finished_after")))
+	}
+	return violations
+}
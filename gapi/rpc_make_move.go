@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"main/pb"
+	"main/utils"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) MakeMove(ctx context.Context, req *pb.MakeMoveRequest) (*pb.MakeMoveResponse, error) {
+	payload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateMakeMoveRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	switch move := req.GetMove().(type) {
+	case *pb.MakeMoveRequest_Column:
+		err = server.wsManager.DropPiece(ctx, req.GetGameId(), payload.Username, int(move.Column))
+	case *pb.MakeMoveRequest_SpookyMarks:
+		err = server.wsManager.PlaceSpookyMarks(ctx, req.GetGameId(), payload.Username, int(move.SpookyMarks.GetFirst()), int(move.SpookyMarks.GetSecond()))
+	case *pb.MakeMoveRequest_Collapse:
+		err = server.wsManager.CollapseCycle(ctx, req.GetGameId(), payload.Username, int(move.Collapse))
+	default:
+		err = server.wsManager.MakeMove(ctx, req.GetGameId(), payload.Username, int(req.GetPosition()))
+	}
+	if err != nil {
+		return nil, gameError(err)
+	}
+
+	game, err := server.wsManager.GetGame(req.GetGameId())
+	if err != nil {
+		return nil, gameError(err)
+	}
+	server.wsManager.BroadcastGameState(req.GetGameId())
+
+	response := &pb.MakeMoveResponse{
+		Game: utils.ConvertGame(req.GetGameId(), game),
+	}
+	return response, nil
+}
+
+func validateMakeMoveRequest(req *pb.MakeMoveRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	switch req.GetMove().(type) {
+	case *pb.MakeMoveRequest_Column:
+		if err := utils.ValidateColumn(req.GetColumn()); err != nil {
+			violations = append(violations, fieldViolation("column", err))
+		}
+	case *pb.MakeMoveRequest_SpookyMarks:
+		if err := utils.ValidatePosition(req.GetSpookyMarks().GetFirst()); err != nil {
+			violations = append(violations, fieldViolation("spooky_marks.first", err))
+		}
+		if err := utils.ValidatePosition(req.GetSpookyMarks().GetSecond()); err != nil {
+			violations = append(violations, fieldViolation("spooky_marks.second", err))
+		}
+	case *pb.MakeMoveRequest_Collapse:
+		if err := utils.ValidatePosition(req.GetCollapse()); err != nil {
+			violations = append(violations, fieldViolation("collapse", err))
+		}
+	default:
+		if err := utils.ValidatePosition(req.GetPosition()); err != nil {
+			violations = append(violations, fieldViolation("position", err))
+		}
+	}
+	return violations
+}
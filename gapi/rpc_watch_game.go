@@ -0,0 +1,103 @@
+package gapi
+
+import (
+	"main/pb"
+	"main/utils"
+	"main/ws"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) WatchGame(req *pb.WatchGameRequest, stream pb.TicTacToe_WatchGameServer) error {
+	ctx := stream.Context()
+
+	if _, err := server.authorizeUser(ctx); err != nil {
+		return unauthenticatedError(err)
+	}
+
+	violations := validateWatchGameRequest(req)
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	// Subscribe before taking the snapshot so no update can slip in between
+	messages, stop := server.wsManager.Watch(req.GetGameId())
+	defer stop()
+
+	game, err := server.wsManager.GetGame(req.GetGameId())
+	if err != nil {
+		return gameError(err)
+	}
+
+	if err := sendGameEvent(stream, pb.GameEvent_GAME_EVENT_SNAPSHOT, req.GetGameId(), game); err != nil {
+		return err
+	}
+
+	for !game.GameOver {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			next, ok := message.Data.(*ws.GameState)
+			if !ok {
+				continue
+			}
+
+			event := gameEvent(game, next)
+			if event == pb.GameEvent_GAME_EVENT_UNSPECIFIED {
+				continue
+			}
+
+			if err := sendGameEvent(stream, event, req.GetGameId(), next); err != nil {
+				return err
+			}
+			game = next
+		}
+	}
+
+	return nil
+}
+
+func sendGameEvent(stream pb.TicTacToe_WatchGameServer, event pb.GameEvent, gameID string, game *ws.GameState) error {
+	err := stream.Send(&pb.WatchGameResponse{
+		Event: event,
+		Game:  utils.ConvertGame(gameID, game),
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "cannot send game update: %s", err)
+	}
+	return nil
+}
+
+// gameEvent classifies the change between two consecutive states of a game,
+// returning GAME_EVENT_UNSPECIFIED when nothing changed
+func gameEvent(previous *ws.GameState, next *ws.GameState) pb.GameEvent {
+	switch {
+	case next.GameOver && !previous.GameOver:
+		return pb.GameEvent_GAME_EVENT_GAME_OVER
+	case next.GameReady && !previous.GameReady:
+		return pb.GameEvent_GAME_EVENT_PLAYER_JOINED
+	case next.Moves != previous.Moves:
+		// Quantum moves that only place spooky marks leave the board unchanged
+		return pb.GameEvent_GAME_EVENT_MOVE_MADE
+	case next.DrawOffer != "" && next.DrawOffer != previous.DrawOffer:
+		return pb.GameEvent_GAME_EVENT_DRAW_OFFERED
+	case next.DrawOffer == "" && previous.DrawOffer != "":
+		return pb.GameEvent_GAME_EVENT_DRAW_DECLINED
+	}
+	return pb.GameEvent_GAME_EVENT_UNSPECIFIED
+}
+
+func validateWatchGameRequest(req *pb.WatchGameRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := utils.ValidateGameID(req.GetGameId()); err != nil {
+		violations = append(violations, fieldViolation("game_id", err))
+	}
+	return violations
+}
@@ -0,0 +1,145 @@
+package gapi
+
+import (
+	"context"
+	db "main/db/sqlc"
+	"main/pb"
+	"main/rules"
+	"main/token"
+	"main/ws"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// memoryStore accepts every write the game manager makes without storing it
+type memoryStore struct {
+	db.Store
+}
+
+func (memoryStore) CreateGameTx(context.Context, db.CreateGameTxParams) (db.CreateGameTxResult, error) {
+	return db.CreateGameTxResult{}, nil
+}
+
+func (memoryStore) JoinGameTx(context.Context, db.JoinGameTxParams) (db.JoinGameTxResult, error) {
+	return db.JoinGameTxResult{}, nil
+}
+
+func (memoryStore) MakeMoveTx(context.Context, db.MakeMoveTxParams) (db.MakeMoveTxResult, error) {
+	return db.MakeMoveTxResult{}, nil
+}
+
+// watchStream collects the responses WatchGame sends
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *pb.WatchGameResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(response *pb.WatchGameResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func TestWatchQuantumGame(t *testing.T) {
+	tokenMaker, err := token.NewPasetoMaker("12345678901234567890123456789012")
+	if err != nil {
+		t.Fatal(err)
+	}
+	accessToken, _, err := tokenMaker.CreateToken("alice", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager := ws.NewManager(memoryStore{}, 0)
+	go manager.Start()
+	server := &Server{tokenMaker: tokenMaker, wsManager: manager}
+
+	ctx := context.Background()
+	const gameID = "quantum_watch"
+	if err := manager.CreateGame(ctx, gameID, "alice", rules.Quantum, ws.GameOptions{Rated: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.JoinGame(ctx, gameID, "bob"); err != nil {
+		t.Fatal(err)
+	}
+
+	md := metadata.Pairs(authorizationHeader, "Bearer "+accessToken)
+	stream := &watchStream{
+		ctx:       metadata.NewIncomingContext(ctx, md),
+		responses: make(chan *pb.WatchGameResponse, 16),
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.WatchGame(&pb.WatchGameRequest{GameId: gameID}, stream)
+	}()
+
+	next := func() *pb.WatchGameResponse {
+		t.Helper()
+		select {
+		case response := <-stream.responses:
+			return response
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a game event")
+			return nil
+		}
+	}
+
+	if response := next(); response.GetEvent() != pb.GameEvent_GAME_EVENT_SNAPSHOT {
+		t.Fatalf("first event %v, want a snapshot", response.GetEvent())
+	}
+
+	// Only the last move, a collapse, changes the board; it completes a
+	// column for each player and X's older line wins
+	moves := []struct {
+		playerID      string
+		first, second int
+		collapse      bool
+	}{
+		{"alice", 3, 5, false},
+		{"bob", 0, 2, false},
+		{"alice", 2, 3, false},
+		{"bob", 6, 8, false},
+		{"alice", 0, 8, false},
+		{"bob", 2, 3, false},
+		{"alice", 3, 0, true},
+	}
+	var response *pb.WatchGameResponse
+	for i, move := range moves {
+		if move.collapse {
+			err = manager.CollapseCycle(ctx, gameID, move.playerID, move.first)
+		} else {
+			err = manager.PlaceSpookyMarks(ctx, gameID, move.playerID, move.first, move.second)
+		}
+		if err != nil {
+			t.Fatalf("move %d: %v", i+1, err)
+		}
+		manager.BroadcastGameState(gameID)
+
+		want := pb.GameEvent_GAME_EVENT_MOVE_MADE
+		if i == len(moves)-1 {
+			want = pb.GameEvent_GAME_EVENT_GAME_OVER
+		}
+		response = next()
+		if response.GetEvent() != want {
+			t.Fatalf("move %d: event %v, want %v", i+1, response.GetEvent(), want)
+		}
+		if got := response.GetGame().GetMoves(); got != int32(i+1) {
+			t.Errorf("move %d: game has %d moves", i+1, got)
+		}
+	}
+
+	if winner := response.GetGame().GetWinner(); winner != "alice" {
+		t.Errorf("winner %q, want alice", winner)
+	}
+	// The stream ends with the game
+	if err := <-done; err != nil {
+		t.Fatalf("WatchGame: %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"main/pb"
 	"main/token"
 	"main/utils"
+	"main/ws"
 )
 
 type Server struct {
@@ -12,9 +13,11 @@ type Server struct {
 	config     utils.Config
 	store      db.Store
 	tokenMaker token.Maker
+	wsManager  *ws.Manager
+	matchmaker *ws.Matchmaker
 }
 
-func NewServer(config utils.Config, store db.Store, tokenMaker token.Maker) (*Server, error) {
+func NewServer(config utils.Config, store db.Store, tokenMaker token.Maker, wsManager *ws.Manager, matchmaker *ws.Matchmaker) (*Server, error) {
 	// tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
 	// if err != nil {
 	// 	return nil, fmt.Errorf("cannot create token maker %w", err)
@@ -24,6 +27,8 @@ func NewServer(config utils.Config, store db.Store, tokenMaker token.Maker) (*Se
 		config:     config,
 		store:      store,
 		tokenMaker: tokenMaker,
+		wsManager:  wsManager,
+		matchmaker: matchmaker,
 	}
 
 	return server, nil
@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	db "main/db/sqlc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getUser loads a user by username, reporting a missing user as NotFound
+func (server *Server) getUser(ctx context.Context, username string) (db.User, error) {
+	user, err := server.store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return user, status.Errorf(codes.NotFound, "user %s not found", username)
+		}
+		return user, status.Errorf(codes.Internal, "cannot fetch user: %s", err)
+	}
+	return user, nil
+}
@@ -62,11 +62,17 @@ func main() {
 	}
 
 	// Initialize WebSocket manager
-	wsManager := ws.NewManager()
+	wsManager := ws.NewManager(store, config.BotMoveDelay)
+	if err := wsManager.RestoreGames(ctx); err != nil {
+		log.Fatal().Err(err).Msg("cannot restore games")
+	}
 	go wsManager.Start()
 
-	runGPRCServer(waitGroupContext, waitGroup, config, store, tokenMaker)
-	runWebSocketServer(waitGroupContext, waitGroup, config, wsManager, tokenMaker)
+	matchmaker := ws.NewMatchmaker(wsManager, config.MatchmakingTimeout)
+	go matchmaker.Start(ctx)
+
+	runGPRCServer(waitGroupContext, waitGroup, config, store, tokenMaker, wsManager, matchmaker)
+	runWebSocketServer(waitGroupContext, waitGroup, config, wsManager, matchmaker, tokenMaker)
 
 	err = waitGroup.Wait()
 	if err != nil {
@@ -74,14 +80,15 @@ func main() {
 	}
 }
 
-func runGPRCServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, store db.Store, tokenMaker token.Maker) {
-	server, err := gapi.NewServer(config, store, tokenMaker)
+func runGPRCServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, store db.Store, tokenMaker token.Maker, wsManager *ws.Manager, matchmaker *ws.Matchmaker) {
+	server, err := gapi.NewServer(config, store, tokenMaker, wsManager, matchmaker)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Cannot create server")
 	}
 
 	grpcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(grpcLogger)
+	grpcStreamLogger := grpc.StreamInterceptor(gapi.GrpcStreamLogger)
+	grpcServer := grpc.NewServer(grpcLogger, grpcStreamLogger)
 	pb.RegisterTicTacToeServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
@@ -114,9 +121,9 @@ func runGPRCServer(ctx context.Context, waitGroup *errgroup.Group, config utils.
 	})
 }
 
-func runWebSocketServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, wsManager *ws.Manager, tokenMaker token.Maker) {
+func runWebSocketServer(ctx context.Context, waitGroup *errgroup.Group, config utils.Config, wsManager *ws.Manager, matchmaker *ws.Matchmaker, tokenMaker token.Maker) {
 	// Create WebSocket auth middleware
-	wsHandler := ws.NewHandler(wsManager, tokenMaker)
+	wsHandler := ws.NewHandler(wsManager, matchmaker, tokenMaker, config.ReplayInterval)
 
 	// Create a new HTTP server for WebSocket
 	mux := http.NewServeMux()
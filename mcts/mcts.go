@@ -0,0 +1,257 @@
+// Package mcts chooses moves by Monte Carlo tree search, for boards too large
+// for the solver to search deeply. Each iteration follows the most promising
+// moves down a tree of positions, adds one new position and finishes the game
+// with random moves; the results steer later iterations.
+package mcts
+
+import (
+	"context"
+	"main/rules"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// DefaultExploration is the UCT exploration constant used when none is given
+const DefaultExploration = math.Sqrt2
+
+// Options limit and tune a search. With neither Iterations nor TimeBudget the
+// search runs DefaultIterations iterations.
+type Options struct {
+	// Iterations is the number of iterations to run, or 0 for no limit
+	Iterations int
+	// TimeBudget is how long to keep searching, or 0 for no limit
+	TimeBudget time.Duration
+	// Seed makes the random playouts repeatable: a search limited by
+	// Iterations alone always returns the same result for the same seed
+	Seed uint64
+	// Exploration trades trying new moves against replaying good ones,
+	// DefaultExploration if zero
+	Exploration float64
+}
+
+// DefaultIterations is the number of iterations of a search without limits
+const DefaultIterations = 10_000
+
+// MoveStats is what the search learned about a legal move
+type MoveStats struct {
+	Move   int
+	Visits int
+	// Value is the average result for the side playing the move, from 0 for
+	// a loss through 0.5 for a draw to 1 for a win
+	Value float64
+}
+
+// Result is the outcome of a search
+type Result struct {
+	// Best is the most visited move
+	Best int
+	// Value is the Value of Best
+	Value float64
+	// Moves holds every move the search tried, most visited first
+	Moves []MoveStats
+	// Iterations counts the iterations run
+	Iterations int
+}
+
+// node is a position in the search tree
+type node struct {
+	position rules.Position
+	move     int // the move that led here from parent
+	parent   *node
+	children []*node
+	untried  []int
+	visits   int
+	// reward sums the results for the side that played move
+	reward float64
+}
+
+func newNode(position rules.Position, move int, parent *node) *node {
+	return &node{position: position, move: move, parent: parent, untried: candidates(position)}
+}
+
+// Search runs Monte Carlo tree search from position, which must not be over,
+// stopping early when ctx is done
+func Search(ctx context.Context, position rules.Position, options Options) Result {
+	if options.Iterations == 0 && options.TimeBudget == 0 {
+		options.Iterations = DefaultIterations
+	}
+	if options.Exploration == 0 {
+		options.Exploration = DefaultExploration
+	}
+
+	if options.TimeBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.TimeBudget)
+		defer cancel()
+	}
+
+	rng := rand.New(rand.NewPCG(options.Seed, options.Seed))
+	root := newNode(position, -1, nil)
+
+	iterations := 0
+	for options.Iterations == 0 || iterations < options.Iterations {
+		// Always finish one iteration so every search has a move
+		if iterations > 0 && iterations%64 == 0 && ctx.Err() != nil {
+			break
+		}
+
+		leaf := root.selectLeaf(options.Exploration)
+		if len(leaf.untried) > 0 {
+			leaf = leaf.expand(rng)
+		}
+		leaf.backpropagate(playout(leaf.position, rng))
+		iterations++
+	}
+
+	moves := make([]MoveStats, len(root.children))
+	for i, child := range root.children {
+		moves[i] = MoveStats{Move: child.move, Visits: child.visits, Value: child.reward / float64(child.visits)}
+	}
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].Visits > moves[j].Visits })
+
+	return Result{
+		Best:       moves[0].Move,
+		Value:      moves[0].Value,
+		Moves:      moves,
+		Iterations: iterations,
+	}
+}
+
+// selectLeaf walks down fully expanded nodes, picking the child with the best
+// upper confidence bound, until it reaches a node with untried moves or the
+// end of the game
+func (n *node) selectLeaf(exploration float64) *node {
+	for len(n.untried) == 0 && len(n.children) > 0 {
+		best, bestBound := n.children[0], math.Inf(-1)
+		logVisits := math.Log(float64(n.visits))
+		for _, child := range n.children {
+			bound := child.reward/float64(child.visits) + exploration*math.Sqrt(logVisits/float64(child.visits))
+			if bound > bestBound {
+				best, bestBound = child, bound
+			}
+		}
+		n = best
+	}
+	return n
+}
+
+// expand adds a child for one of the untried moves, picked at random
+func (n *node) expand(rng *rand.Rand) *node {
+	i := rng.IntN(len(n.untried))
+	move := n.untried[i]
+	n.untried[i] = n.untried[len(n.untried)-1]
+	n.untried = n.untried[:len(n.untried)-1]
+
+	next, err := n.position.Play(move)
+	if err != nil {
+		// Candidates are legal moves, so this cannot happen
+		return n
+	}
+	child := newNode(next, move, n)
+	n.children = append(n.children, child)
+	return child
+}
+
+// backpropagate records the winner of a playout, or Empty for a draw, on the
+// path from n up to the root
+func (n *node) backpropagate(winner string) {
+	for ; n != nil; n = n.parent {
+		n.visits++
+		if n.parent == nil {
+			continue
+		}
+		// Some variants let a side move twice, so the mover is asked for
+		switch winner {
+		case n.parent.position.ToMove():
+			n.reward++
+		case rules.Empty:
+			n.reward += 0.5
+		}
+	}
+}
+
+// playout finishes the game with random moves and returns the winner, or
+// Empty for a draw
+func playout(position rules.Position, rng *rand.Rand) string {
+	for !position.Outcome().Over() {
+		next, err := position.Play(randomMove(position, rng))
+		if err != nil {
+			break
+		}
+		position = next
+	}
+	return position.Outcome().Winner()
+}
+
+// openBoardCells is the size from which open boards only consider cells near
+// the symbols already placed
+const openBoardCells = 49
+
+// neighbourhood is how far from a placed symbol a cell is still considered
+const neighbourhood = 2
+
+// isOpenBoard reports whether settings describe a large board of the
+// m,n,k-game, where nearly every cell is legal but moves far from all symbols
+// are pointless
+func isOpenBoard(settings rules.Settings) bool {
+	return settings.Cells() >= openBoardCells &&
+		(settings.Variant == rules.VariantStandard || settings.Variant == rules.VariantMisere)
+}
+
+// candidates returns the moves worth considering: on open boards only cells
+// close to a placed symbol
+func candidates(position rules.Position) []int {
+	moves := position.LegalMoves()
+	if !isOpenBoard(position.Settings()) {
+		return moves
+	}
+	settings := position.Settings()
+
+	var near []int
+	for _, move := range moves {
+		if nearSymbol(position, move) {
+			near = append(near, move)
+		}
+	}
+	if len(near) == 0 {
+		// Start in the centre of an empty board
+		centre := rules.Coordinates{X: settings.Width / 2, Y: settings.Height / 2}
+		return []int{settings.CellAt(centre)}
+	}
+	return near
+}
+
+// randomMove returns one of the candidates at random. Playouts call it for
+// every move, so on open boards it tries a few legal moves before working out
+// all the candidates.
+func randomMove(position rules.Position, rng *rand.Rand) int {
+	moves := position.LegalMoves()
+	if !isOpenBoard(position.Settings()) {
+		return moves[rng.IntN(len(moves))]
+	}
+
+	for range 8 {
+		if move := moves[rng.IntN(len(moves))]; nearSymbol(position, move) {
+			return move
+		}
+	}
+	near := candidates(position)
+	return near[rng.IntN(len(near))]
+}
+
+// nearSymbol reports whether a symbol lies within neighbourhood of cell
+func nearSymbol(position rules.Position, cell int) bool {
+	settings := position.Settings()
+	c := settings.CoordinatesOf(cell)
+	for dy := -neighbourhood; dy <= neighbourhood; dy++ {
+		for dx := -neighbourhood; dx <= neighbourhood; dx++ {
+			other := settings.CellAt(rules.Coordinates{X: c.X + dx, Y: c.Y + dy, Z: c.Z})
+			if other >= 0 && position.Cell(other) != rules.Empty {
+				return true
+			}
+		}
+	}
+	return false
+}
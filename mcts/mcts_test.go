@@ -0,0 +1,134 @@
+package mcts
+
+import (
+	"context"
+	"main/rules"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSearchIsRepeatable(t *testing.T) {
+	position := rules.NewPosition(rules.Standard)
+	options := Options{Iterations: 2000, Seed: 42}
+
+	first, second := Search(context.Background(), position, options), Search(context.Background(), position, options)
+	if first.Best != second.Best || first.Iterations != second.Iterations {
+		t.Errorf("best %d after %d iterations, then %d after %d",
+			first.Best, first.Iterations, second.Best, second.Iterations)
+	}
+	if !slices.Equal(first.Moves, second.Moves) {
+		t.Errorf("moves %v, then %v", first.Moves, second.Moves)
+	}
+	if first.Iterations != options.Iterations {
+		t.Errorf("ran %d iterations, want %d", first.Iterations, options.Iterations)
+	}
+}
+
+func TestSearchFindsForcedMoves(t *testing.T) {
+	tests := []struct {
+		name  string
+		board rules.Board
+		best  int
+	}{
+		// X to move completes the top row
+		{"win", rules.Board{"X", "X", "", "O", "O", "", "", "", ""}, 2},
+		// O to move must block the diagonal
+		{"block", rules.Board{"X", "", "", "", "X", "", "", "", ""}, 8},
+		// X to move wins rather than blocking O's row
+		{"win over block", rules.Board{"X", "X", "", "O", "O", "", "X", "", "O"}, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := rules.PositionOf(rules.Standard, tc.board)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := Search(context.Background(), position, Options{Iterations: 5000, Seed: 1})
+			if result.Best != tc.best {
+				t.Errorf("best move %d, want %d; moves %v", result.Best, tc.best, result.Moves)
+			}
+		})
+	}
+}
+
+// near reports whether cell lies within neighbourhood of a symbol on the
+// board of position
+func near(position rules.Position, cell int) bool {
+	settings := position.Settings()
+	c := settings.CoordinatesOf(cell)
+	for other, symbol := range position.Board() {
+		o := settings.CoordinatesOf(other)
+		if symbol != rules.Empty && max(abs(o.X-c.X), abs(o.Y-c.Y)) <= neighbourhood {
+			return true
+		}
+	}
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func TestOpenBoardStartsInTheCentre(t *testing.T) {
+	tests := []struct {
+		settings rules.Settings
+		centre   int
+	}{
+		{rules.Settings{Variant: rules.VariantStandard, Width: 9, Height: 9, WinLength: 5, Depth: 1}, 40},
+		{rules.Settings{Variant: rules.VariantStandard, Width: 15, Height: 15, WinLength: 5, Depth: 1}, 112},
+		{rules.Settings{Variant: rules.VariantMisere, Width: 10, Height: 8, WinLength: 4, Depth: 1}, 45},
+	}
+
+	for _, tc := range tests {
+		result := Search(context.Background(), rules.NewPosition(tc.settings), Options{Iterations: 100, Seed: 1})
+		if result.Best != tc.centre || len(result.Moves) != 1 {
+			t.Errorf("%dx%d: best move %d of %d, want only the centre %d",
+				tc.settings.Width, tc.settings.Height, result.Best, len(result.Moves), tc.centre)
+		}
+	}
+}
+
+func TestOpenBoardStaysNearSymbols(t *testing.T) {
+	settings := rules.Settings{Variant: rules.VariantStandard, Width: 15, Height: 15, WinLength: 5, Depth: 1}
+	position := rules.NewPosition(settings)
+	for _, move := range []int{112, 113, 97} {
+		next, err := position.Play(move)
+		if err != nil {
+			t.Fatal(err)
+		}
+		position = next
+	}
+
+	result := Search(context.Background(), position, Options{Iterations: 2000, Seed: 1})
+	for _, move := range result.Moves {
+		if !near(position, move.Move) {
+			t.Errorf("search tried %d, further than %d cells from every symbol", move.Move, neighbourhood)
+		}
+	}
+
+	// Playouts keep to the same cells as the game fills up
+	rng := rand.New(rand.NewPCG(1, 1))
+	for i := 0; i < 40 && !position.Outcome().Over(); i++ {
+		move := randomMove(position, rng)
+		if !near(position, move) {
+			t.Fatalf("playout move %d is %d, further than %d cells from every symbol", i+1, move, neighbourhood)
+		}
+		next, err := position.Play(move)
+		if err != nil {
+			t.Fatal(err)
+		}
+		position = next
+	}
+}
+
+func TestSmallBoardConsidersEveryCell(t *testing.T) {
+	settings := rules.Settings{Variant: rules.VariantStandard, Width: 6, Height: 6, WinLength: 4, Depth: 1}
+	if got := candidates(rules.NewPosition(settings)); len(got) != settings.Cells() {
+		t.Errorf("%d candidates on an empty 6x6 board, want all %d cells", len(got), settings.Cells())
+	}
+}
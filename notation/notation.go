@@ -0,0 +1,419 @@
+// Package notation reads and writes tic-tac-toe games in a PGN-like text format.
+//
+// A game is a block of tag pairs followed by its movetext:
+//
+//	[Game "test_game_123"]
+//	[Variant "standard"]
+//	[Board "3x3"]
+//	[WinLength "3"]
+//	[X "alice"]
+//	[O "bob"]
+//	[Created "2025-01-01T12:00:00Z"]
+//	[Finished "2025-01-01T12:00:09Z"]
+//	[Result "1-0"]
+//
+//	1. b2 {2025-01-01T12:00:01Z} a1 {2025-01-01T12:00:03Z} 2. c3 a3 3. a2 c1 4. b1 b3 5. c2 1-0
+//
+// Cells are named by column (a, b, c, ... from the left) and row (1, 2, 3, ...
+// from the top), so on a 3x3 board a1 is position 0 and c3 is position 8. A
+// comment after a move holds the time it was played. In the gravity variant a
+// move is the letter of the column the piece is dropped into. Three
+// dimensional boards such as [Board "3x3x3"] add the layer after a colon, so
+// b2:1 is the centre of the top layer. Quantum moves join the cells of two
+// spooky marks with a tilde, as in a1~c3; the classical mark on the last free
+// cell is just its cell, and a collapse into a cell is written @b2. Several
+// games can follow each other in one document.
+//
+// The Variant tag defaults to standard and, when given, comes before the Board
+// and WinLength tags, which default to the usual board of the variant. A game
+// that ended before its moves decided it carries a Termination tag,
+// [Termination "resignation"] or [Termination "agreement"] for a draw both
+// players agreed to.
+package notation
+
+import (
+	"fmt"
+	"main/rules"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Results of a game
+const (
+	ResultXWins   = "1-0"
+	ResultOWins   = "0-1"
+	ResultDraw    = "1/2-1/2"
+	ResultOngoing = "*"
+)
+
+// Ways a game ended off the board, as given by its Termination tag
+const (
+	TerminationResignation = "resignation"
+	TerminationAgreement   = "agreement"
+)
+
+// Game is a game described in notation
+type Game struct {
+	GameID   string
+	Settings rules.Settings
+	PlayerX  string
+	PlayerO  string
+	Result   string
+	// Termination is how a game that the moves leave unfinished ended, or
+	// empty if it was played out
+	Termination string
+	CreatedAt   time.Time // zero if unknown
+	FinishedAt  time.Time // zero if unknown
+	Moves       []Move
+}
+
+// Move is a single ply of a game
+type Move struct {
+	Position int       // cell, or column in variants where pieces drop
+	PlayedAt time.Time // zero if unknown
+}
+
+// Cell returns the name of a board position, e.g. "b2" for 4 on a 3x3 board
+// or "b2:2" for 13 on a 3x3x3 board
+func Cell(position int, settings rules.Settings) string {
+	c := settings.CoordinatesOf(position)
+	name := fmt.Sprintf("%c%d", 'a'+c.X, c.Y+1)
+	if settings.Depth > 1 {
+		name += fmt.Sprintf(":%d", c.Z+1)
+	}
+	return name
+}
+
+// ParseCell returns the board position named by a cell such as "b2", or
+// "b2:2" on a three dimensional board
+func ParseCell(cell string, settings rules.Settings) (int, error) {
+	square, layer, layered := strings.Cut(cell, ":")
+	if len(square) < 2 || layered != (settings.Depth > 1) {
+		return 0, fmt.Errorf("invalid cell %q", cell)
+	}
+
+	c := rules.Coordinates{X: int(square[0] - 'a')}
+	row, err := strconv.Atoi(square[1:])
+	if err != nil {
+		return 0, fmt.Errorf("invalid cell %q", cell)
+	}
+	c.Y = row - 1
+	if layered {
+		z, err := strconv.Atoi(layer)
+		if err != nil {
+			return 0, fmt.Errorf("invalid cell %q", cell)
+		}
+		c.Z = z - 1
+	}
+
+	position := settings.CellAt(c)
+	if position < 0 {
+		return 0, fmt.Errorf("invalid cell %q", cell)
+	}
+	return position, nil
+}
+
+// MoveName returns the name of a move: its cell, in variants where pieces
+// drop the letter of its column, or in quantum games the cells of its spooky
+// marks or collapse
+func MoveName(move int, settings rules.Settings) string {
+	if settings.UsesColumns() {
+		return fmt.Sprintf("%c", 'a'+move)
+	}
+	if settings.IsQuantum() {
+		first, second, collapse, _ := rules.ParseQuantumMove(move)
+		switch {
+		case collapse:
+			return "@" + Cell(first, settings)
+		case first == second:
+			return Cell(first, settings)
+		}
+		return Cell(first, settings) + "~" + Cell(second, settings)
+	}
+	return Cell(move, settings)
+}
+
+// ParseMove returns the move named by a cell, a column letter in variants
+// where pieces drop, or a quantum move such as a1~c3 or @b2
+func ParseMove(name string, settings rules.Settings) (int, error) {
+	if settings.IsQuantum() {
+		return parseQuantumMove(name, settings)
+	}
+	if !settings.UsesColumns() {
+		return ParseCell(name, settings)
+	}
+
+	if len(name) != 1 || name[0] < 'a' || int(name[0]-'a') >= settings.Width {
+		return 0, fmt.Errorf("invalid column %q", name)
+	}
+	return int(name[0] - 'a'), nil
+}
+
+func parseQuantumMove(name string, settings rules.Settings) (int, error) {
+	if cell, collapse := strings.CutPrefix(name, "@"); collapse {
+		position, err := ParseCell(cell, settings)
+		if err != nil {
+			return 0, err
+		}
+		return rules.CollapseMove(position), nil
+	}
+
+	firstCell, secondCell, spooky := strings.Cut(name, "~")
+	if !spooky {
+		secondCell = firstCell
+	}
+	first, err := ParseCell(firstCell, settings)
+	if err != nil {
+		return 0, err
+	}
+	second, err := ParseCell(secondCell, settings)
+	if err != nil {
+		return 0, err
+	}
+	return rules.QuantumMove(first, second), nil
+}
+
+// Format writes a game in notation
+func Format(game *Game) string {
+	var sb strings.Builder
+
+	writeTag(&sb, "Game", game.GameID)
+	writeTag(&sb, "Variant", game.Settings.Variant)
+	board := fmt.Sprintf("%dx%d", game.Settings.Width, game.Settings.Height)
+	if game.Settings.Depth > 1 {
+		board += fmt.Sprintf("x%d", game.Settings.Depth)
+	}
+	writeTag(&sb, "Board", board)
+	writeTag(&sb, "WinLength", strconv.Itoa(game.Settings.WinLength))
+	writeTag(&sb, "X", game.PlayerX)
+	writeTag(&sb, "O", game.PlayerO)
+	if !game.CreatedAt.IsZero() {
+		writeTag(&sb, "Created", formatTime(game.CreatedAt))
+	}
+	if !game.FinishedAt.IsZero() {
+		writeTag(&sb, "Finished", formatTime(game.FinishedAt))
+	}
+	if game.Termination != "" {
+		writeTag(&sb, "Termination", game.Termination)
+	}
+	writeTag(&sb, "Result", game.Result)
+	sb.WriteByte('\n')
+
+	for i, move := range game.Moves {
+		if i%2 == 0 {
+			fmt.Fprintf(&sb, "%d. ", i/2+1)
+		}
+		sb.WriteString(MoveName(move.Position, game.Settings))
+		if !move.PlayedAt.IsZero() {
+			fmt.Fprintf(&sb, " {%s}", formatTime(move.PlayedAt))
+		}
+		sb.WriteByte(' ')
+	}
+	sb.WriteString(game.Result)
+	sb.WriteByte('\n')
+
+	return sb.String()
+}
+
+func writeTag(sb *strings.Builder, name string, value string) {
+	fmt.Fprintf(sb, "[%s %s]\n", name, strconv.Quote(value))
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Parse reads every game of a notation document. It checks the syntax only;
+// whether the moves are legal is up to the caller.
+func Parse(text string) ([]*Game, error) {
+	var games []*Game
+	var game *Game
+	var inMovetext bool
+
+	tokens, err := tokenize(text)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		if game == nil {
+			game = &Game{Settings: rules.Standard}
+			inMovetext = false
+		}
+		fail := func(format string, args ...interface{}) ([]*Game, error) {
+			return nil, fmt.Errorf("game %d: %s", len(games)+1, fmt.Sprintf(format, args...))
+		}
+
+		switch {
+		case strings.HasPrefix(token, "["):
+			if inMovetext {
+				return fail("missing result before tag %s", token)
+			}
+			if err := parseTag(game, token); err != nil {
+				return fail("%s", err)
+			}
+
+		case strings.HasPrefix(token, "{"):
+			if len(game.Moves) == 0 {
+				return fail("comment %s before the first move", token)
+			}
+			playedAt, err := time.Parse(time.RFC3339, strings.TrimSpace(token[1:len(token)-1]))
+			if err != nil {
+				return fail("invalid move time %s", token)
+			}
+			game.Moves[len(game.Moves)-1].PlayedAt = playedAt
+
+		case isResult(token):
+			if game.Result != "" && game.Result != token {
+				return fail("movetext result %s does not match Result tag %s", token, game.Result)
+			}
+			game.Result = token
+			if game.PlayerX == "" || game.PlayerO == "" {
+				return fail("missing X or O tag")
+			}
+			games = append(games, game)
+			game = nil
+
+		case strings.HasSuffix(token, "."):
+			inMovetext = true
+			number, err := strconv.Atoi(strings.TrimSuffix(token, "."))
+			if err != nil || len(game.Moves)%2 != 0 || number != len(game.Moves)/2+1 {
+				return fail("unexpected move number %s", token)
+			}
+
+		default:
+			inMovetext = true
+			position, err := ParseMove(token, game.Settings)
+			if err != nil {
+				return fail("%s", err)
+			}
+			game.Moves = append(game.Moves, Move{Position: position})
+		}
+	}
+
+	if game != nil {
+		return nil, fmt.Errorf("game %d: missing result", len(games)+1)
+	}
+	return games, nil
+}
+
+// parseTag reads a tag pair such as [X "alice"] into game
+func parseTag(game *Game, token string) error {
+	name, quoted, found := strings.Cut(token[1:len(token)-1], " ")
+	if !found {
+		return fmt.Errorf("invalid tag %s", token)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(quoted))
+	if err != nil {
+		return fmt.Errorf("invalid tag %s", token)
+	}
+
+	switch name {
+	case "Game":
+		game.GameID = value
+	case "Variant":
+		// The variant brings its own board, so Board and WinLength follow it
+		game.Settings = rules.DefaultSettings(value)
+		game.Settings.Variant = value
+	case "X":
+		game.PlayerX = value
+	case "O":
+		game.PlayerO = value
+	case "Board":
+		// Flat boards give two dimensions, three dimensional boards three
+		dimensions := strings.Split(value, "x")
+		if len(dimensions) != 2 && len(dimensions) != 3 {
+			return fmt.Errorf("invalid board %q", value)
+		}
+		sizes := []int{1, 1, 1}
+		for i, dimension := range dimensions {
+			size, err := strconv.Atoi(dimension)
+			if err != nil {
+				return fmt.Errorf("invalid board %q", value)
+			}
+			sizes[i] = size
+		}
+		game.Settings.Width, game.Settings.Height, game.Settings.Depth = sizes[0], sizes[1], sizes[2]
+	case "WinLength":
+		winLength, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid win length %q", value)
+		}
+		game.Settings.WinLength = winLength
+	case "Result":
+		if !isResult(value) {
+			return fmt.Errorf("invalid result %q", value)
+		}
+		game.Result = value
+	case "Termination":
+		if value != TerminationResignation && value != TerminationAgreement {
+			return fmt.Errorf("invalid termination %q", value)
+		}
+		game.Termination = value
+	case "Created", "Finished":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid %s time %q", name, value)
+		}
+		if name == "Created" {
+			game.CreatedAt = t
+		} else {
+			game.FinishedAt = t
+		}
+	}
+	// Unknown tags are ignored so documents can carry extra information
+
+	return nil
+}
+
+func isResult(token string) bool {
+	switch token {
+	case ResultXWins, ResultOWins, ResultDraw, ResultOngoing:
+		return true
+	}
+	return false
+}
+
+// tokenize splits a document into tags, comments and whitespace separated words
+func tokenize(text string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(text); {
+		switch c := text[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '[' || c == '{':
+			closing := byte(']')
+			if c == '{' {
+				closing = '}'
+			}
+			end := strings.IndexByte(text[i:], closing)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated %c", c)
+			}
+			tokens = append(tokens, text[i:i+end+1])
+			i += end + 1
+
+		default:
+			end := strings.IndexAny(text[i:], " \t\r\n[{")
+			if end < 0 {
+				end = len(text) - i
+			}
+			word := text[i : i+end]
+			// Allow move numbers written without a space, e.g. "1.b2"
+			if number, rest, found := strings.Cut(word, "."); found && rest != "" {
+				if _, err := strconv.Atoi(number); err == nil {
+					tokens = append(tokens, number+".", rest)
+					i += end
+					continue
+				}
+			}
+			tokens = append(tokens, word)
+			i += end
+		}
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,270 @@
+package notation
+
+import (
+	"main/rules"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func at(seconds int) time.Time {
+	return time.Date(2025, 1, 1, 12, 0, seconds, 0, time.UTC)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		game Game
+	}{
+		{"finished", Game{
+			GameID:     "test_game_123",
+			Settings:   rules.Standard,
+			PlayerX:    "alice",
+			PlayerO:    "bob",
+			Result:     ResultXWins,
+			CreatedAt:  at(0),
+			FinishedAt: at(9),
+			Moves: []Move{
+				{Position: 4, PlayedAt: at(1)}, {Position: 0, PlayedAt: at(3)},
+				{Position: 8}, {Position: 6}, {Position: 3}, {Position: 2},
+				{Position: 1}, {Position: 7}, {Position: 5},
+			},
+		}},
+		{"ongoing without moves", Game{
+			GameID:   "empty",
+			Settings: rules.Standard,
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultOngoing,
+		}},
+		{"large board", Game{
+			GameID:   "gomoku",
+			Settings: rules.Settings{Variant: rules.VariantStandard, Width: 15, Height: 12, WinLength: 5, Depth: 1},
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultOngoing,
+			Moves:    []Move{{Position: 0}, {Position: 179}, {Position: 14}, {Position: 165}},
+		}},
+		{"ultimate", Game{
+			GameID:   "ultimate",
+			Settings: rules.Ultimate,
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultOngoing,
+			Moves:    []Move{{Position: 40}, {Position: 30}, {Position: 10}},
+		}},
+		{"qubic", Game{
+			GameID:   "qubic",
+			Settings: rules.Qubic,
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultOngoing,
+			Moves:    []Move{{Position: 0}, {Position: 21}, {Position: 42}, {Position: 63}},
+		}},
+		{"quantum", Game{
+			GameID:   "quantum",
+			Settings: rules.Quantum,
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultOngoing,
+			Moves: []Move{
+				{Position: rules.QuantumMove(0, 1)}, {Position: rules.QuantumMove(1, 2)},
+				{Position: rules.QuantumMove(0, 2)}, {Position: rules.CollapseMove(2)},
+			},
+		}},
+		{"resigned", Game{
+			GameID:      "resigned",
+			Settings:    rules.Standard,
+			PlayerX:     "alice",
+			PlayerO:     "bob",
+			Result:      ResultOWins,
+			Termination: TerminationResignation,
+			Moves:       []Move{{Position: 4}, {Position: 0}},
+		}},
+		{"agreed draw", Game{
+			GameID:      "agreed",
+			Settings:    rules.Standard,
+			PlayerX:     "alice",
+			PlayerO:     "bob",
+			Result:      ResultDraw,
+			Termination: TerminationAgreement,
+		}},
+		{"gravity", Game{
+			GameID:   "gravity",
+			Settings: rules.Gravity,
+			PlayerX:  "alice",
+			PlayerO:  "bob",
+			Result:   ResultXWins,
+			Moves: []Move{
+				{Position: 3}, {Position: 3}, {Position: 4}, {Position: 0},
+				{Position: 5}, {Position: 6}, {Position: 2},
+			},
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			text := Format(&tc.game)
+			games, err := Parse(text)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", text, err)
+			}
+			if len(games) != 1 || !reflect.DeepEqual(*games[0], tc.game) {
+				t.Errorf("Parse(Format(game)) = %+v, want %+v", games, tc.game)
+			}
+		})
+	}
+}
+
+func TestParseDocument(t *testing.T) {
+	text := `[X "alice"] [O "bob"] [Event "casual"]
+1.b2 a1 2.c3 1/2-1/2
+
+[X "carol"]
+[O "dave"]
+1. a1 {2025-01-01T12:00:01Z} *`
+
+	games, err := Parse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("got %d games, want 2", len(games))
+	}
+
+	first := games[0]
+	if first.Settings != rules.Standard || first.Result != ResultDraw {
+		t.Errorf("first game has settings %+v and result %q", first.Settings, first.Result)
+	}
+	if got := []Move{{Position: 4}, {Position: 0}, {Position: 8}}; !reflect.DeepEqual(first.Moves, got) {
+		t.Errorf("first game moves %v, want %v", first.Moves, got)
+	}
+	if second := games[1]; second.PlayerX != "carol" || !second.Moves[0].PlayedAt.Equal(at(1)) {
+		t.Errorf("second game %+v", second)
+	}
+}
+
+func TestParseVariantBoard(t *testing.T) {
+	tests := []struct {
+		name string
+		tags string
+		want rules.Settings
+	}{
+		{"no tags", "", rules.Standard},
+		{"variant alone", `[Variant "ultimate"]`, rules.Ultimate},
+		{"notakto", `[Variant "notakto"]`, rules.Notakto},
+		{"gravity", `[Variant "gravity"]`, rules.Gravity},
+		{"qubic", `[Variant "qubic"]`, rules.Qubic},
+		{"layered board", `[Variant "3d"] [Board "3x3x3"]`, rules.Cube},
+		{"board after variant", `[Variant "standard"] [Board "5x4"] [WinLength "4"]`,
+			rules.Settings{Variant: rules.VariantStandard, Width: 5, Height: 4, WinLength: 4, Depth: 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			games, err := Parse(tc.tags + ` [X "alice"] [O "bob"] *`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := games[0].Settings; got != tc.want {
+				t.Errorf("settings %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tags := `[X "alice"] [O "bob"] `
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"cell off the board", tags + "1. d1 *", "invalid cell"},
+		{"cell without a row", tags + "1. b *", "invalid cell"},
+		{"row below the board", `[Board "4x4"] ` + tags + "1. a5 *", "invalid cell"},
+		{"cell in a gravity game", `[Variant "gravity"] ` + tags + "1. a1 *", "invalid column"},
+		{"column off the board", `[Variant "gravity"] ` + tags + "1. h *", "invalid column"},
+		{"invalid board", `[Board "3by3"] ` + tags + "*", "invalid board"},
+		{"board with four dimensions", `[Board "3x3x3x3"] ` + tags + "*", "invalid board"},
+		{"flat cell on a layered board", `[Variant "3d"] ` + tags + "1. b2 *", "invalid cell"},
+		{"layer below the board", `[Variant "3d"] ` + tags + "1. b2:4 *", "invalid cell"},
+		{"layer on a flat board", tags + "1. b2:1 *", "invalid cell"},
+		{"spooky mark off the board", `[Variant "quantum"] ` + tags + "1. a1~d1 *", "invalid cell"},
+		{"collapse without a cell", `[Variant "quantum"] ` + tags + "1. a1~b1 @ *", "invalid cell"},
+		{"spooky marks in a standard game", tags + "1. a1~b1 *", "invalid cell"},
+		{"invalid win length", `[WinLength "three"] ` + tags + "*", "invalid win length"},
+		{"move number out of order", tags + "2. b2 *", "unexpected move number"},
+		{"move number between a pair", tags + "1. b2 2. a1 *", "unexpected move number"},
+		{"missing result", tags + "1. b2", "missing result"},
+		{"result mismatch", `[Result "1-0"] ` + tags + "1. b2 0-1", "does not match"},
+		{"invalid result tag", `[Result "2-0"] ` + tags + "*", "invalid result"},
+		{"missing player", `[X "alice"] 1. b2 *`, "missing X or O tag"},
+		{"unterminated tag", `[X "alice"`, "unterminated"},
+		{"unquoted tag", `[X alice] [O "bob"] *`, "invalid tag"},
+		{"comment before a move", tags + "{2025-01-01T12:00:01Z} 1. b2 *", "before the first move"},
+		{"invalid move time", tags + "1. b2 {yesterday} *", "invalid move time"},
+		{"unknown termination", `[Termination "timeout"] ` + tags + "*", "invalid termination"},
+		{"invalid created time", `[Created "yesterday"] ` + tags + "*", "invalid Created time"},
+		{"tag after moves", `[X "alice"] 1. b2 [O "bob"] *`, "missing result before tag"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.text)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Parse(%q) = %v, want an error containing %q", tc.text, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestCell(t *testing.T) {
+	settings := rules.Settings{Variant: rules.VariantStandard, Width: 12, Height: 10, WinLength: 5, Depth: 1}
+	for position := 0; position < settings.Cells(); position++ {
+		name := Cell(position, settings)
+		got, err := ParseCell(name, settings)
+		if err != nil || got != position {
+			t.Errorf("ParseCell(Cell(%d) = %q) = %d, %v", position, name, got, err)
+		}
+	}
+	if name := Cell(5, rules.Standard); name != "c2" {
+		t.Errorf("Cell(5) = %q on a 3x3 board, want c2", name)
+	}
+	if name := Cell(119, settings); name != "l10" {
+		t.Errorf("Cell(119) = %q on a 12x10 board, want l10", name)
+	}
+}
+
+func TestMoveName(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings rules.Settings
+		move     int
+	}{
+		{"b2", rules.Standard, 4},
+		{"i9", rules.Ultimate, 80},
+		{"a", rules.Gravity, 0},
+		{"g", rules.Gravity, 6},
+		{"b2:1", rules.Cube, 4},
+		{"c3:3", rules.Cube, 26},
+		{"d4:4", rules.Qubic, 63},
+		{"a1~c3", rules.Quantum, rules.QuantumMove(0, 8)},
+		{"c3~a1", rules.Quantum, rules.QuantumMove(8, 0)},
+		{"b2", rules.Quantum, rules.QuantumMove(4, 4)},
+		{"@b3", rules.Quantum, rules.CollapseMove(7)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MoveName(tc.move, tc.settings); got != tc.name {
+				t.Errorf("MoveName(%d) = %q, want %q", tc.move, got, tc.name)
+			}
+			if got, err := ParseMove(tc.name, tc.settings); err != nil || got != tc.move {
+				t.Errorf("ParseMove(%q) = %d, %v, want %d", tc.name, got, err, tc.move)
+			}
+		})
+	}
+}
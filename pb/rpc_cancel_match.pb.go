@@ -0,0 +1,155 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_cancel_match.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancelMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchRequest) Reset() {
+	*x = CancelMatchRequest{}
+	mi := &file_rpc_cancel_match_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchRequest) ProtoMessage() {}
+
+func (x *CancelMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_cancel_match_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelMatchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_cancel_match_proto_rawDescGZIP(), []int{0}
+}
+
+type CancelMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchResponse) Reset() {
+	*x = CancelMatchResponse{}
+	mi := &file_rpc_cancel_match_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchResponse) ProtoMessage() {}
+
+func (x *CancelMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_cancel_match_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelMatchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_cancel_match_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_cancel_match_proto protoreflect.FileDescriptor
+
+var file_rpc_cancel_match_proto_rawDesc = string([]byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_cancel_match_proto_rawDescOnce sync.Once
+	file_rpc_cancel_match_proto_rawDescData []byte
+)
+
+func file_rpc_cancel_match_proto_rawDescGZIP() []byte {
+	file_rpc_cancel_match_proto_rawDescOnce.Do(func() {
+		file_rpc_cancel_match_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_cancel_match_proto_rawDesc), len(file_rpc_cancel_match_proto_rawDesc)))
+	})
+	return file_rpc_cancel_match_proto_rawDescData
+}
+
+var file_rpc_cancel_match_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_cancel_match_proto_goTypes = []any{
+	(*CancelMatchRequest)(nil),  // 0: tic_tac_toe.CancelMatchRequest
+	(*CancelMatchResponse)(nil), // 1: tic_tac_toe.CancelMatchResponse
+}
+var file_rpc_cancel_match_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_cancel_match_proto_init() }
+func file_rpc_cancel_match_proto_init() {
+	if File_rpc_cancel_match_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_cancel_match_proto_rawDesc), len(file_rpc_cancel_match_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_cancel_match_proto_goTypes,
+		DependencyIndexes: file_rpc_cancel_match_proto_depIdxs,
+		MessageInfos:      file_rpc_cancel_match_proto_msgTypes,
+	}.Build()
+	File_rpc_cancel_match_proto = out.File
+	file_rpc_cancel_match_proto_goTypes = nil
+	file_rpc_cancel_match_proto_depIdxs = nil
+}
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_get_game_participants.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetGameParticipantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameParticipantsRequest) Reset() {
+	*x = GetGameParticipantsRequest{}
+	mi := &file_rpc_get_game_participants_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameParticipantsRequest) ProtoMessage() {}
+
+func (x *GetGameParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_game_participants_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*GetGameParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_game_participants_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGameParticipantsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameParticipantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participants  []*GameParticipant     `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameParticipantsResponse) Reset() {
+	*x = GetGameParticipantsResponse{}
+	mi := &file_rpc_get_game_participants_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameParticipantsResponse) ProtoMessage() {}
+
+func (x *GetGameParticipantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_game_participants_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*GetGameParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_game_participants_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGameParticipantsResponse) GetParticipants() []*GameParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+var File_rpc_get_game_participants_proto protoreflect.FileDescriptor
+
+var file_rpc_get_game_participants_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x1a, 0x0a,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63,
+	0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_get_game_participants_proto_rawDescOnce sync.Once
+	file_rpc_get_game_participants_proto_rawDescData []byte
+)
+
+func file_rpc_get_game_participants_proto_rawDescGZIP() []byte {
+	file_rpc_get_game_participants_proto_rawDescOnce.Do(func() {
+		file_rpc_get_game_participants_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_game_participants_proto_rawDesc), len(file_rpc_get_game_participants_proto_rawDesc)))
+	})
+	return file_rpc_get_game_participants_proto_rawDescData
+}
+
+var file_rpc_get_game_participants_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_game_participants_proto_goTypes = []any{
+	(*GetGameParticipantsRequest)(nil),  // 0: tic_tac_toe.GetGameParticipantsRequest
+	(*GetGameParticipantsResponse)(nil), // 1: tic_tac_toe.GetGameParticipantsResponse
+	(*GameParticipant)(nil),             // 2: tic_tac_toe.GameParticipant
+}
+var file_rpc_get_game_participants_proto_depIdxs = []int32{
+	2, // 0: tic_tac_toe.GetGameParticipantsResponse.participants:type_name -> tic_tac_toe.GameParticipant
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_game_participants_proto_init() }
+func file_rpc_get_game_participants_proto_init() {
+	if File_rpc_get_game_participants_proto != nil {
+		return
+	}
+	file_game_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_game_participants_proto_rawDesc), len(file_rpc_get_game_participants_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_game_participants_proto_goTypes,
+		DependencyIndexes: file_rpc_get_game_participants_proto_depIdxs,
+		MessageInfos:      file_rpc_get_game_participants_proto_msgTypes,
+	}.Build()
+	File_rpc_get_game_participants_proto = out.File
+	file_rpc_get_game_participants_proto_goTypes = nil
+	file_rpc_get_game_participants_proto_depIdxs = nil
+}
@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_get_leaderboard.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LeaderboardOrder int32
+
+const (
+	LeaderboardOrder_LEADERBOARD_ORDER_UNSPECIFIED LeaderboardOrder = 0
+	LeaderboardOrder_LEADERBOARD_ORDER_RATING      LeaderboardOrder = 1
+	LeaderboardOrder_LEADERBOARD_ORDER_WINS        LeaderboardOrder = 2
+	LeaderboardOrder_LEADERBOARD_ORDER_WIN_RATE    LeaderboardOrder = 3
+)
+
+// Enum value maps for LeaderboardOrder.
+var (
+	LeaderboardOrder_name = map[int32]string{
+		0: "LEADERBOARD_ORDER_UNSPECIFIED",
+		1: "LEADERBOARD_ORDER_RATING",
+		2: "LEADERBOARD_ORDER_WINS",
+		3: "LEADERBOARD_ORDER_WIN_RATE",
+	}
+	LeaderboardOrder_value = map[string]int32{
+		"LEADERBOARD_ORDER_UNSPECIFIED": 0,
+		"LEADERBOARD_ORDER_RATING":      1,
+		"LEADERBOARD_ORDER_WINS":        2,
+		"LEADERBOARD_ORDER_WIN_RATE":    3,
+	}
+)
+
+func (x LeaderboardOrder) Enum() *LeaderboardOrder {
+	p := new(LeaderboardOrder)
+	*p = x
+	return p
+}
+
+func (x LeaderboardOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_get_leaderboard_proto_enumTypes[0].Descriptor()
+}
+
+func (LeaderboardOrder) Type() protoreflect.EnumType {
+	return &file_rpc_get_leaderboard_proto_enumTypes[0]
+}
+
+func (x LeaderboardOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardOrder.Descriptor instead.
+func (LeaderboardOrder) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_get_leaderboard_proto_rawDescGZIP(), []int{0}
+}
+
+type LeaderboardWindow int32
+
+const (
+	LeaderboardWindow_LEADERBOARD_WINDOW_UNSPECIFIED LeaderboardWindow = 0
+	LeaderboardWindow_LEADERBOARD_WINDOW_ALL_TIME    LeaderboardWindow = 1
+	LeaderboardWindow_LEADERBOARD_WINDOW_MONTH       LeaderboardWindow = 2
+	LeaderboardWindow_LEADERBOARD_WINDOW_WEEK        LeaderboardWindow = 3
+)
+
+// Enum value maps for LeaderboardWindow.
+var (
+	LeaderboardWindow_name = map[int32]string{
+		0: "LEADERBOARD_WINDOW_UNSPECIFIED",
+		1: "LEADERBOARD_WINDOW_ALL_TIME",
+		2: "LEADERBOARD_WINDOW_MONTH",
+		3: "LEADERBOARD_WINDOW_WEEK",
+	}
+	LeaderboardWindow_value = map[string]int32{
+		"LEADERBOARD_WINDOW_UNSPECIFIED": 0,
+		"LEADERBOARD_WINDOW_ALL_TIME":    1,
+		"LEADERBOARD_WINDOW_MONTH":       2,
+		"LEADERBOARD_WINDOW_WEEK":        3,
+	}
+)
+
+func (x LeaderboardWindow) Enum() *LeaderboardWindow {
+	p := new(LeaderboardWindow)
+	*p = x
+	return p
+}
+
+func (x LeaderboardWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_get_leaderboard_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardWindow) Type() protoreflect.EnumType {
+	return &file_rpc_get_leaderboard_proto_enumTypes[1]
+}
+
+func (x LeaderboardWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardWindow.Descriptor instead.
+func (LeaderboardWindow) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_get_leaderboard_proto_rawDescGZIP(), []int{1}
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int64                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	GamesPlayed   int64                  `protobuf:"varint,4,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins          int64                  `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses        int64                  `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws         int64                  `protobuf:"varint,7,opt,name=draws,proto3" json:"draws,omitempty"`
+	WinRate       float64                `protobuf:"fixed64,8,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_get_leaderboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LeaderboardEntry) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetGamesPlayed() int64 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDraws() int64 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+type GetLeaderboardRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrderBy  LeaderboardOrder       `protobuf:"varint,1,opt,name=order_by,json=orderBy,proto3,enum=tic_tac_toe.LeaderboardOrder" json:"order_by,omitempty"`
+	Window   LeaderboardWindow      `protobuf:"varint,2,opt,name=window,proto3,enum=tic_tac_toe.LeaderboardWindow" json:"window,omitempty"`
+	PageId   int32                  `protobuf:"varint,3,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Restricts the ranking to these players (plus the caller). The server has
+	// no friend model, so this is just the list the client sends, e.g. the
+	// friends it keeps itself; at most 100 names.
+	Usernames     []string `protobuf:"bytes,5,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_leaderboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLeaderboardRequest) GetOrderBy() LeaderboardOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return LeaderboardOrder_LEADERBOARD_ORDER_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_LEADERBOARD_WINDOW_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetUsernames() []string {
+	if x != nil {
+		return x.Usernames
+	}
+	return nil
+}
+
+type GetLeaderboardResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Entries    []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	TotalCount int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// The caller's own entry, unset if they played no rated game in the window
+	MyEntry       *LeaderboardEntry `protobuf:"bytes,3,opt,name=my_entry,json=myEntry,proto3" json:"my_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_leaderboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_leaderboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetLeaderboardResponse) GetMyEntry() *LeaderboardEntry {
+	if x != nil {
+		return x.MyEntry
+	}
+	return nil
+}
+
+var File_rpc_get_leaderboard_proto protoreflect.FileDescriptor
+
+var file_rpc_get_leaderboard_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f,
+	0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x79,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x2a, 0x8f, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x41,
+	0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x45,
+	0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x57, 0x49, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x93, 0x01, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x1e,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44,
+	0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x02, 0x12,
+	0x1b, 0x0a, 0x17, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x03, 0x42, 0x09, 0x5a, 0x07,
+	0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_get_leaderboard_proto_rawDescOnce sync.Once
+	file_rpc_get_leaderboard_proto_rawDescData []byte
+)
+
+func file_rpc_get_leaderboard_proto_rawDescGZIP() []byte {
+	file_rpc_get_leaderboard_proto_rawDescOnce.Do(func() {
+		file_rpc_get_leaderboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_leaderboard_proto_rawDesc), len(file_rpc_get_leaderboard_proto_rawDesc)))
+	})
+	return file_rpc_get_leaderboard_proto_rawDescData
+}
+
+var file_rpc_get_leaderboard_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rpc_get_leaderboard_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_get_leaderboard_proto_goTypes = []any{
+	(LeaderboardOrder)(0),          // 0: tic_tac_toe.LeaderboardOrder
+	(LeaderboardWindow)(0),         // 1: tic_tac_toe.LeaderboardWindow
+	(*LeaderboardEntry)(nil),       // 2: tic_tac_toe.LeaderboardEntry
+	(*GetLeaderboardRequest)(nil),  // 3: tic_tac_toe.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil), // 4: tic_tac_toe.GetLeaderboardResponse
+}
+var file_rpc_get_leaderboard_proto_depIdxs = []int32{
+	0, // 0: tic_tac_toe.GetLeaderboardRequest.order_by:type_name -> tic_tac_toe.LeaderboardOrder
+	1, // 1: tic_tac_toe.GetLeaderboardRequest.window:type_name -> tic_tac_toe.LeaderboardWindow
+	2, // 2: tic_tac_toe.GetLeaderboardResponse.entries:type_name -> tic_tac_toe.LeaderboardEntry
+	2, // 3: tic_tac_toe.GetLeaderboardResponse.my_entry:type_name -> tic_tac_toe.LeaderboardEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_leaderboard_proto_init() }
+func file_rpc_get_leaderboard_proto_init() {
+	if File_rpc_get_leaderboard_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_leaderboard_proto_rawDesc), len(file_rpc_get_leaderboard_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_leaderboard_proto_goTypes,
+		DependencyIndexes: file_rpc_get_leaderboard_proto_depIdxs,
+		EnumInfos:         file_rpc_get_leaderboard_proto_enumTypes,
+		MessageInfos:      file_rpc_get_leaderboard_proto_msgTypes,
+	}.Build()
+	File_rpc_get_leaderboard_proto = out.File
+	file_rpc_get_leaderboard_proto_goTypes = nil
+	file_rpc_get_leaderboard_proto_depIdxs = nil
+}
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_import_games.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notation      string                 `protobuf:"bytes,1,opt,name=notation,proto3" json:"notation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGamesRequest) Reset() {
+	*x = ImportGamesRequest{}
+	mi := &file_rpc_import_games_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGamesRequest) ProtoMessage() {}
+
+func (x *ImportGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_import_games_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGamesRequest.ProtoReflect.Descriptor instead.
+func (*ImportGamesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_import_games_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportGamesRequest) GetNotation() string {
+	if x != nil {
+		return x.Notation
+	}
+	return ""
+}
+
+type ImportGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameIds       []string               `protobuf:"bytes,1,rep,name=game_ids,json=gameIds,proto3" json:"game_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportGamesResponse) Reset() {
+	*x = ImportGamesResponse{}
+	mi := &file_rpc_import_games_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGamesResponse) ProtoMessage() {}
+
+func (x *ImportGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_import_games_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGamesResponse.ProtoReflect.Descriptor instead.
+func (*ImportGamesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_import_games_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportGamesResponse) GetGameIds() []string {
+	if x != nil {
+		return x.GameIds
+	}
+	return nil
+}
+
+var File_rpc_import_games_proto protoreflect.FileDescriptor
+
+var file_rpc_import_games_proto_rawDesc = string([]byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x22, 0x30, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69,
+	0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_import_games_proto_rawDescOnce sync.Once
+	file_rpc_import_games_proto_rawDescData []byte
+)
+
+func file_rpc_import_games_proto_rawDescGZIP() []byte {
+	file_rpc_import_games_proto_rawDescOnce.Do(func() {
+		file_rpc_import_games_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_import_games_proto_rawDesc), len(file_rpc_import_games_proto_rawDesc)))
+	})
+	return file_rpc_import_games_proto_rawDescData
+}
+
+var file_rpc_import_games_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_import_games_proto_goTypes = []any{
+	(*ImportGamesRequest)(nil),  // 0: tic_tac_toe.ImportGamesRequest
+	(*ImportGamesResponse)(nil), // 1: tic_tac_toe.ImportGamesResponse
+}
+var file_rpc_import_games_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_import_games_proto_init() }
+func file_rpc_import_games_proto_init() {
+	if File_rpc_import_games_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_import_games_proto_rawDesc), len(file_rpc_import_games_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_import_games_proto_goTypes,
+		DependencyIndexes: file_rpc_import_games_proto_depIdxs,
+		MessageInfos:      file_rpc_import_games_proto_msgTypes,
+	}.Build()
+	File_rpc_import_games_proto = out.File
+	file_rpc_import_games_proto_goTypes = nil
+	file_rpc_import_games_proto_depIdxs = nil
+}
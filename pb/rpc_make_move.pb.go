@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_make_move.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MakeMoveRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Types that are valid to be assigned to Move:
+	//
+	//	*MakeMoveRequest_Position
+	//	*MakeMoveRequest_Column
+	//	*MakeMoveRequest_SpookyMarks
+	//	*MakeMoveRequest_Collapse
+	Move          isMakeMoveRequest_Move `protobuf_oneof:"move"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeMoveRequest) Reset() {
+	*x = MakeMoveRequest{}
+	mi := &file_rpc_make_move_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeMoveRequest) ProtoMessage() {}
+
+func (x *MakeMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_make_move_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeMoveRequest.ProtoReflect.Descriptor instead.
+func (*MakeMoveRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_make_move_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MakeMoveRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MakeMoveRequest) GetMove() isMakeMoveRequest_Move {
+	if x != nil {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *MakeMoveRequest) GetPosition() int32 {
+	if x != nil {
+		if x, ok := x.Move.(*MakeMoveRequest_Position); ok {
+			return x.Position
+		}
+	}
+	return 0
+}
+
+func (x *MakeMoveRequest) GetColumn() int32 {
+	if x != nil {
+		if x, ok := x.Move.(*MakeMoveRequest_Column); ok {
+			return x.Column
+		}
+	}
+	return 0
+}
+
+func (x *MakeMoveRequest) GetSpookyMarks() *SpookyMarks {
+	if x != nil {
+		if x, ok := x.Move.(*MakeMoveRequest_SpookyMarks); ok {
+			return x.SpookyMarks
+		}
+	}
+	return nil
+}
+
+func (x *MakeMoveRequest) GetCollapse() int32 {
+	if x != nil {
+		if x, ok := x.Move.(*MakeMoveRequest_Collapse); ok {
+			return x.Collapse
+		}
+	}
+	return 0
+}
+
+type isMakeMoveRequest_Move interface {
+	isMakeMoveRequest_Move()
+}
+
+type MakeMoveRequest_Position struct {
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3,oneof"`
+}
+
+type MakeMoveRequest_Column struct {
+	// Gravity games name the column a piece is dropped into
+	Column int32 `protobuf:"varint,3,opt,name=column,proto3,oneof"`
+}
+
+type MakeMoveRequest_SpookyMarks struct {
+	// Quantum games place spooky marks, then the opponent of a player who
+	// closes a cycle picks the cell its closing mark collapses into
+	SpookyMarks *SpookyMarks `protobuf:"bytes,4,opt,name=spooky_marks,json=spookyMarks,proto3,oneof"`
+}
+
+type MakeMoveRequest_Collapse struct {
+	Collapse int32 `protobuf:"varint,5,opt,name=collapse,proto3,oneof"`
+}
+
+func (*MakeMoveRequest_Position) isMakeMoveRequest_Move() {}
+
+func (*MakeMoveRequest_Column) isMakeMoveRequest_Move() {}
+
+func (*MakeMoveRequest_SpookyMarks) isMakeMoveRequest_Move() {}
+
+func (*MakeMoveRequest_Collapse) isMakeMoveRequest_Move() {}
+
+// Two cells to place spooky marks on, or the same cell twice for the
+// classical mark on the last free cell of a quantum game
+type SpookyMarks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	First         int32                  `protobuf:"varint,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second        int32                  `protobuf:"varint,2,opt,name=second,proto3" json:"second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpookyMarks) Reset() {
+	*x = SpookyMarks{}
+	mi := &file_rpc_make_move_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpookyMarks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpookyMarks) ProtoMessage() {}
+
+func (x *SpookyMarks) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_make_move_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpookyMarks.ProtoReflect.Descriptor instead.
+func (*SpookyMarks) Descriptor() ([]byte, []int) {
+	return file_rpc_make_move_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SpookyMarks) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *SpookyMarks) GetSecond() int32 {
+	if x != nil {
+		return x.Second
+	}
+	return 0
+}
+
+type MakeMoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Game          *Game                  `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeMoveResponse) Reset() {
+	*x = MakeMoveResponse{}
+	mi := &file_rpc_make_move_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeMoveResponse) ProtoMessage() {}
+
+func (x *MakeMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_make_move_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeMoveResponse.ProtoReflect.Descriptor instead.
+func (*MakeMoveResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_make_move_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MakeMoveResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+var File_rpc_make_move_proto protoreflect.FileDescriptor
+
+var file_rpc_make_move_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74,
+	0x6f, 0x65, 0x1a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7,
+	0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x6f, 0x6b, 0x79, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x69, 0x63, 0x5f,
+	0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x53, 0x70, 0x6f, 0x6f, 0x6b, 0x79, 0x4d, 0x61,
+	0x72, 0x6b, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x6f, 0x6b, 0x79, 0x4d, 0x61, 0x72,
+	0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x70, 0x6f, 0x6f,
+	0x6b, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x39, 0x0a, 0x10, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_make_move_proto_rawDescOnce sync.Once
+	file_rpc_make_move_proto_rawDescData []byte
+)
+
+func file_rpc_make_move_proto_rawDescGZIP() []byte {
+	file_rpc_make_move_proto_rawDescOnce.Do(func() {
+		file_rpc_make_move_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_make_move_proto_rawDesc), len(file_rpc_make_move_proto_rawDesc)))
+	})
+	return file_rpc_make_move_proto_rawDescData
+}
+
+var file_rpc_make_move_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_make_move_proto_goTypes = []any{
+	(*MakeMoveRequest)(nil),  // 0: tic_tac_toe.MakeMoveRequest
+	(*SpookyMarks)(nil),      // 1: tic_tac_toe.SpookyMarks
+	(*MakeMoveResponse)(nil), // 2: tic_tac_toe.MakeMoveResponse
+	(*Game)(nil),             // 3: tic_tac_toe.Game
+}
+var file_rpc_make_move_proto_depIdxs = []int32{
+	1, // 0: tic_tac_toe.MakeMoveRequest.spooky_marks:type_name -> tic_tac_toe.SpookyMarks
+	3, // 1: tic_tac_toe.MakeMoveResponse.game:type_name -> tic_tac_toe.Game
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_make_move_proto_init() }
+func file_rpc_make_move_proto_init() {
+	if File_rpc_make_move_proto != nil {
+		return
+	}
+	file_game_proto_init()
+	file_rpc_make_move_proto_msgTypes[0].OneofWrappers = []any{
+		(*MakeMoveRequest_Position)(nil),
+		(*MakeMoveRequest_Column)(nil),
+		(*MakeMoveRequest_SpookyMarks)(nil),
+		(*MakeMoveRequest_Collapse)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_make_move_proto_rawDesc), len(file_rpc_make_move_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_make_move_proto_goTypes,
+		DependencyIndexes: file_rpc_make_move_proto_depIdxs,
+		MessageInfos:      file_rpc_make_move_proto_msgTypes,
+	}.Build()
+	File_rpc_make_move_proto = out.File
+	file_rpc_make_move_proto_goTypes = nil
+	file_rpc_make_move_proto_depIdxs = nil
+}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        v5.29.3
+// source: rpc_watch_game.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameEvent int32
+
+const (
+	GameEvent_GAME_EVENT_UNSPECIFIED   GameEvent = 0
+	GameEvent_GAME_EVENT_SNAPSHOT      GameEvent = 1
+	GameEvent_GAME_EVENT_PLAYER_JOINED GameEvent = 2
+	GameEvent_GAME_EVENT_MOVE_MADE     GameEvent = 3
+	GameEvent_GAME_EVENT_GAME_OVER     GameEvent = 4
+	GameEvent_GAME_EVENT_DRAW_OFFERED  GameEvent = 5
+	GameEvent_GAME_EVENT_DRAW_DECLINED GameEvent = 6
+)
+
+// Enum value maps for GameEvent.
+var (
+	GameEvent_name = map[int32]string{
+		0: "GAME_EVENT_UNSPECIFIED",
+		1: "GAME_EVENT_SNAPSHOT",
+		2: "GAME_EVENT_PLAYER_JOINED",
+		3: "GAME_EVENT_MOVE_MADE",
+		4: "GAME_EVENT_GAME_OVER",
+		5: "GAME_EVENT_DRAW_OFFERED",
+		6: "GAME_EVENT_DRAW_DECLINED",
+	}
+	GameEvent_value = map[string]int32{
+		"GAME_EVENT_UNSPECIFIED":   0,
+		"GAME_EVENT_SNAPSHOT":      1,
+		"GAME_EVENT_PLAYER_JOINED": 2,
+		"GAME_EVENT_MOVE_MADE":     3,
+		"GAME_EVENT_GAME_OVER":     4,
+		"GAME_EVENT_DRAW_OFFERED":  5,
+		"GAME_EVENT_DRAW_DECLINED": 6,
+	}
+)
+
+func (x GameEvent) Enum() *GameEvent {
+	p := new(GameEvent)
+	*p = x
+	return p
+}
+
+func (x GameEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_watch_game_proto_enumTypes[0].Descriptor()
+}
+
+func (GameEvent) Type() protoreflect.EnumType {
+	return &file_rpc_watch_game_proto_enumTypes[0]
+}
+
+func (x GameEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameEvent.Descriptor instead.
+func (GameEvent) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_watch_game_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameRequest) Reset() {
+	*x = WatchGameRequest{}
+	mi := &file_rpc_watch_game_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameRequest) ProtoMessage() {}
+
+func (x *WatchGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_game_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameRequest.ProtoReflect.Descriptor instead.
+func (*WatchGameRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_game_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type WatchGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         GameEvent              `protobuf:"varint,1,opt,name=event,proto3,enum=tic_tac_toe.GameEvent" json:"event,omitempty"`
+	Game          *Game                  `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchGameResponse) Reset() {
+	*x = WatchGameResponse{}
+	mi := &file_rpc_watch_game_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGameResponse) ProtoMessage() {}
+
+func (x *WatchGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_watch_game_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGameResponse.ProtoReflect.Descriptor instead.
+func (*WatchGameResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_watch_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchGameResponse) GetEvent() GameEvent {
+	if x != nil {
+		return x.Event
+	}
+	return GameEvent_GAME_EVENT_UNSPECIFIED
+}
+
+func (x *WatchGameResponse) GetGame() *Game {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+var File_rpc_watch_game_proto protoreflect.FileDescriptor
+
+var file_rpc_watch_game_proto_rawDesc = string([]byte{
+	0x0a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f,
+	0x74, 0x6f, 0x65, 0x1a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x2b, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x11,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x2a, 0xcd, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4a,
+	0x4f, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4d, 0x41, 0x44, 0x45, 0x10,
+	0x03, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x4f,
+	0x46, 0x46, 0x45, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x4d, 0x45,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x52, 0x41, 0x57, 0x5f, 0x44, 0x45, 0x43, 0x4c,
+	0x49, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_watch_game_proto_rawDescOnce sync.Once
+	file_rpc_watch_game_proto_rawDescData []byte
+)
+
+func file_rpc_watch_game_proto_rawDescGZIP() []byte {
+	file_rpc_watch_game_proto_rawDescOnce.Do(func() {
+		file_rpc_watch_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_watch_game_proto_rawDesc), len(file_rpc_watch_game_proto_rawDesc)))
+	})
+	return file_rpc_watch_game_proto_rawDescData
+}
+
+var file_rpc_watch_game_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rpc_watch_game_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_watch_game_proto_goTypes = []any{
+	(GameEvent)(0),            // 0: tic_tac_toe.GameEvent
+	(*WatchGameRequest)(nil),  // 1: tic_tac_toe.WatchGameRequest
+	(*WatchGameResponse)(nil), // 2: tic_tac_toe.WatchGameResponse
+	(*Game)(nil),              // 3: tic_tac_toe.Game
+}
+var file_rpc_watch_game_proto_depIdxs = []int32{
+	0, // 0: tic_tac_toe.WatchGameResponse.event:type_name -> tic_tac_toe.GameEvent
+	3, // 1: tic_tac_toe.WatchGameResponse.game:type_name -> tic_tac_toe.Game
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_watch_game_proto_init() }
+func file_rpc_watch_game_proto_init() {
+	if File_rpc_watch_game_proto != nil {
+		return
+	}
+	file_game_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_watch_game_proto_rawDesc), len(file_rpc_watch_game_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_watch_game_proto_goTypes,
+		DependencyIndexes: file_rpc_watch_game_proto_depIdxs,
+		EnumInfos:         file_rpc_watch_game_proto_enumTypes,
+		MessageInfos:      file_rpc_watch_game_proto_msgTypes,
+	}.Build()
+	File_rpc_watch_game_proto = out.File
+	file_rpc_watch_game_proto_goTypes = nil
+	file_rpc_watch_game_proto_depIdxs = nil
+}
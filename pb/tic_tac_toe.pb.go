@@ -27,37 +27,223 @@ var file_tic_tac_toe_proto_rawDesc = string([]byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65,
 	0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xaa, 0x01,
-	0x0a, 0x09, 0x54, 0x69, 0x63, 0x54, 0x61, 0x63, 0x54, 0x6f, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f,
-	0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x5f,
-	0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f,
-	0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74,
-	0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61,
-	0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72,
+	0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x6d,
+	0x61, 0x6b, 0x65, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12,
+	0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65,
+	0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x66,
+	0x69, 0x6e, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x16, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x70,
+	0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x72, 0x70, 0x63, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x72,
+	0x70, 0x63, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdb, 0x0c, 0x0a, 0x09, 0x54, 0x69, 0x63, 0x54, 0x61, 0x63,
+	0x54, 0x6f, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x08, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74,
+	0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74,
+	0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x67, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f,
+	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f,
+	0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74,
+	0x6f, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x22, 0x2e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f,
+	0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x12, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61,
+	0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x54, 0x6f, 0x48,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x12, 0x21, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74,
+	0x6f, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74,
+	0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74,
+	0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x69,
+	0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x63, 0x5f, 0x74, 0x6f, 0x65, 0x2e, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x09, 0x5a, 0x07, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_tic_tac_toe_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),  // 0: tic_tac_toe.CreateUserRequest
-	(*LoginUserRequest)(nil),   // 1: tic_tac_toe.LoginUserRequest
-	(*CreateUserResponse)(nil), // 2: tic_tac_toe.CreateUserResponse
-	(*LoginUserResponse)(nil),  // 3: tic_tac_toe.LoginUserResponse
+	(*CreateUserRequest)(nil),           // 0: tic_tac_toe.CreateUserRequest
+	(*LoginUserRequest)(nil),            // 1: tic_tac_toe.LoginUserRequest
+	(*CreateGameRequest)(nil),           // 2: tic_tac_toe.CreateGameRequest
+	(*JoinGameRequest)(nil),             // 3: tic_tac_toe.JoinGameRequest
+	(*MakeMoveRequest)(nil),             // 4: tic_tac_toe.MakeMoveRequest
+	(*GetGameRequest)(nil),              // 5: tic_tac_toe.GetGameRequest
+	(*WatchGameRequest)(nil),            // 6: tic_tac_toe.WatchGameRequest
+	(*ListAvailableGamesRequest)(nil),   // 7: tic_tac_toe.ListAvailableGamesRequest
+	(*GetGameParticipantsRequest)(nil),  // 8: tic_tac_toe.GetGameParticipantsRequest
+	(*FindMatchRequest)(nil),            // 9: tic_tac_toe.FindMatchRequest
+	(*CancelMatchRequest)(nil),          // 10: tic_tac_toe.CancelMatchRequest
+	(*GetLeaderboardRequest)(nil),       // 11: tic_tac_toe.GetLeaderboardRequest
+	(*GetUserStatsRequest)(nil),         // 12: tic_tac_toe.GetUserStatsRequest
+	(*ListUserGamesRequest)(nil),        // 13: tic_tac_toe.ListUserGamesRequest
+	(*GetHeadToHeadRequest)(nil),        // 14: tic_tac_toe.GetHeadToHeadRequest
+	(*GetGameReplayRequest)(nil),        // 15: tic_tac_toe.GetGameReplayRequest
+	(*ExportGameRequest)(nil),           // 16: tic_tac_toe.ExportGameRequest
+	(*ImportGamesRequest)(nil),          // 17: tic_tac_toe.ImportGamesRequest
+	(*AnalyzeGameRequest)(nil),          // 18: tic_tac_toe.AnalyzeGameRequest
+	(*CreateUserResponse)(nil),          // 19: tic_tac_toe.CreateUserResponse
+	(*LoginUserResponse)(nil),           // 20: tic_tac_toe.LoginUserResponse
+	(*CreateGameResponse)(nil),          // 21: tic_tac_toe.CreateGameResponse
+	(*JoinGameResponse)(nil),            // 22: tic_tac_toe.JoinGameResponse
+	(*MakeMoveResponse)(nil),            // 23: tic_tac_toe.MakeMoveResponse
+	(*GetGameResponse)(nil),             // 24: tic_tac_toe.GetGameResponse
+	(*WatchGameResponse)(nil),           // 25: tic_tac_toe.WatchGameResponse
+	(*ListAvailableGamesResponse)(nil),  // 26: tic_tac_toe.ListAvailableGamesResponse
+	(*GetGameParticipantsResponse)(nil), // 27: tic_tac_toe.GetGameParticipantsResponse
+	(*FindMatchResponse)(nil),           // 28: tic_tac_toe.FindMatchResponse
+	(*CancelMatchResponse)(nil),         // 29: tic_tac_toe.CancelMatchResponse
+	(*GetLeaderboardResponse)(nil),      // 30: tic_tac_toe.GetLeaderboardResponse
+	(*GetUserStatsResponse)(nil),        // 31: tic_tac_toe.GetUserStatsResponse
+	(*ListUserGamesResponse)(nil),       // 32: tic_tac_toe.ListUserGamesResponse
+	(*GetHeadToHeadResponse)(nil),       // 33: tic_tac_toe.GetHeadToHeadResponse
+	(*GetGameReplayResponse)(nil),       // 34: tic_tac_toe.GetGameReplayResponse
+	(*ExportGameResponse)(nil),          // 35: tic_tac_toe.ExportGameResponse
+	(*ImportGamesResponse)(nil),         // 36: tic_tac_toe.ImportGamesResponse
+	(*AnalyzeGameResponse)(nil),         // 37: tic_tac_toe.AnalyzeGameResponse
 }
 var file_tic_tac_toe_proto_depIdxs = []int32{
-	0, // 0: tic_tac_toe.TicTacToe.CreateUser:input_type -> tic_tac_toe.CreateUserRequest
-	1, // 1: tic_tac_toe.TicTacToe.LoginUser:input_type -> tic_tac_toe.LoginUserRequest
-	2, // 2: tic_tac_toe.TicTacToe.CreateUser:output_type -> tic_tac_toe.CreateUserResponse
-	3, // 3: tic_tac_toe.TicTacToe.LoginUser:output_type -> tic_tac_toe.LoginUserResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: tic_tac_toe.TicTacToe.CreateUser:input_type -> tic_tac_toe.CreateUserRequest
+	1,  // 1: tic_tac_toe.TicTacToe.LoginUser:input_type -> tic_tac_toe.LoginUserRequest
+	2,  // 2: tic_tac_toe.TicTacToe.CreateGame:input_type -> tic_tac_toe.CreateGameRequest
+	3,  // 3: tic_tac_toe.TicTacToe.JoinGame:input_type -> tic_tac_toe.JoinGameRequest
+	4,  // 4: tic_tac_toe.TicTacToe.MakeMove:input_type -> tic_tac_toe.MakeMoveRequest
+	5,  // 5: tic_tac_toe.TicTacToe.GetGame:input_type -> tic_tac_toe.GetGameRequest
+	6,  // 6: tic_tac_toe.TicTacToe.WatchGame:input_type -> tic_tac_toe.WatchGameRequest
+	7,  // 7: tic_tac_toe.TicTacToe.ListAvailableGames:input_type -> tic_tac_toe.ListAvailableGamesRequest
+	8,  // 8: tic_tac_toe.TicTacToe.GetGameParticipants:input_type -> tic_tac_toe.GetGameParticipantsRequest
+	9,  // 9: tic_tac_toe.TicTacToe.FindMatch:input_type -> tic_tac_toe.FindMatchRequest
+	10, // 10: tic_tac_toe.TicTacToe.CancelMatch:input_type -> tic_tac_toe.CancelMatchRequest
+	11, // 11: tic_tac_toe.TicTacToe.GetLeaderboard:input_type -> tic_tac_toe.GetLeaderboardRequest
+	12, // 12: tic_tac_toe.TicTacToe.GetUserStats:input_type -> tic_tac_toe.GetUserStatsRequest
+	13, // 13: tic_tac_toe.TicTacToe.ListUserGames:input_type -> tic_tac_toe.ListUserGamesRequest
+	14, // 14: tic_tac_toe.TicTacToe.GetHeadToHead:input_type -> tic_tac_toe.GetHeadToHeadRequest
+	15, // 15: tic_tac_toe.TicTacToe.GetGameReplay:input_type -> tic_tac_toe.GetGameReplayRequest
+	16, // 16: tic_tac_toe.TicTacToe.ExportGame:input_type -> tic_tac_toe.ExportGameRequest
+	17, // 17: tic_tac_toe.TicTacToe.ImportGames:input_type -> tic_tac_toe.ImportGamesRequest
+	18, // 18: tic_tac_toe.TicTacToe.AnalyzeGame:input_type -> tic_tac_toe.AnalyzeGameRequest
+	19, // 19: tic_tac_toe.TicTacToe.CreateUser:output_type -> tic_tac_toe.CreateUserResponse
+	20, // 20: tic_tac_toe.TicTacToe.LoginUser:output_type -> tic_tac_toe.LoginUserResponse
+	21, // 21: tic_tac_toe.TicTacToe.CreateGame:output_type -> tic_tac_toe.CreateGameResponse
+	22, // 22: tic_tac_toe.TicTacToe.JoinGame:output_type -> tic_tac_toe.JoinGameResponse
+	23, // 23: tic_tac_toe.TicTacToe.MakeMove:output_type -> tic_tac_toe.MakeMoveResponse
+	24, // 24: tic_tac_toe.TicTacToe.GetGame:output_type -> tic_tac_toe.GetGameResponse
+	25, // 25: tic_tac_toe.TicTacToe.WatchGame:output_type -> tic_tac_toe.WatchGameResponse
+	26, // 26: tic_tac_toe.TicTacToe.ListAvailableGames:output_type -> tic_tac_toe.ListAvailableGamesResponse
+	27, // 27: tic_tac_toe.TicTacToe.GetGameParticipants:output_type -> tic_tac_toe.GetGameParticipantsResponse
+	28, // 28: tic_tac_toe.TicTacToe.FindMatch:output_type -> tic_tac_toe.FindMatchResponse
+	29, // 29: tic_tac_toe.TicTacToe.CancelMatch:output_type -> tic_tac_toe.CancelMatchResponse
+	30, // 30: tic_tac_toe.TicTacToe.GetLeaderboard:output_type -> tic_tac_toe.GetLeaderboardResponse
+	31, // 31: tic_tac_toe.TicTacToe.GetUserStats:output_type -> tic_tac_toe.GetUserStatsResponse
+	32, // 32: tic_tac_toe.TicTacToe.ListUserGames:output_type -> tic_tac_toe.ListUserGamesResponse
+	33, // 33: tic_tac_toe.TicTacToe.GetHeadToHead:output_type -> tic_tac_toe.GetHeadToHeadResponse
+	34, // 34: tic_tac_toe.TicTacToe.GetGameReplay:output_type -> tic_tac_toe.GetGameReplayResponse
+	35, // 35: tic_tac_toe.TicTacToe.ExportGame:output_type -> tic_tac_toe.ExportGameResponse
+	36, // 36: tic_tac_toe.TicTacToe.ImportGames:output_type -> tic_tac_toe.ImportGamesResponse
+	37, // 37: tic_tac_toe.TicTacToe.AnalyzeGame:output_type -> tic_tac_toe.AnalyzeGameResponse
+	19, // [19:38] is the sub-list for method output_type
+	0,  // [0:19] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_tic_tac_toe_proto_init() }
@@ -67,6 +253,23 @@ func file_tic_tac_toe_proto_init() {
 	}
 	file_rpc_create_user_proto_init()
 	file_rpc_login_user_proto_init()
+	file_rpc_create_game_proto_init()
+	file_rpc_join_game_proto_init()
+	file_rpc_make_move_proto_init()
+	file_rpc_get_game_proto_init()
+	file_rpc_watch_game_proto_init()
+	file_rpc_list_available_games_proto_init()
+	file_rpc_get_game_participants_proto_init()
+	file_rpc_find_match_proto_init()
+	file_rpc_cancel_match_proto_init()
+	file_rpc_get_leaderboard_proto_init()
+	file_rpc_get_user_stats_proto_init()
+	file_rpc_list_user_games_proto_init()
+	file_rpc_get_head_to_head_proto_init()
+	file_rpc_get_game_replay_proto_init()
+	file_rpc_export_game_proto_init()
+	file_rpc_import_games_proto_init()
+	file_rpc_analyze_game_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -19,8 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TicTacToe_CreateUser_FullMethodName = "/tic_tac_toe.TicTacToe/CreateUser"
-	TicTacToe_LoginUser_FullMethodName  = "/tic_tac_toe.TicTacToe/LoginUser"
+	TicTacToe_CreateUser_FullMethodName          = "/tic_tac_toe.TicTacToe/CreateUser"
+	TicTacToe_LoginUser_FullMethodName           = "/tic_tac_toe.TicTacToe/LoginUser"
+	TicTacToe_CreateGame_FullMethodName          = "/tic_tac_toe.TicTacToe/CreateGame"
+	TicTacToe_JoinGame_FullMethodName            = "/tic_tac_toe.TicTacToe/JoinGame"
+	TicTacToe_MakeMove_FullMethodName            = "/tic_tac_toe.TicTacToe/MakeMove"
+	TicTacToe_GetGame_FullMethodName             = "/tic_tac_toe.TicTacToe/GetGame"
+	TicTacToe_WatchGame_FullMethodName           = "/tic_tac_toe.TicTacToe/WatchGame"
+	TicTacToe_ListAvailableGames_FullMethodName  = "/tic_tac_toe.TicTacToe/ListAvailableGames"
+	TicTacToe_GetGameParticipants_FullMethodName = "/tic_tac_toe.TicTacToe/GetGameParticipants"
+	TicTacToe_FindMatch_FullMethodName           = "/tic_tac_toe.TicTacToe/FindMatch"
+	TicTacToe_CancelMatch_FullMethodName         = "/tic_tac_toe.TicTacToe/CancelMatch"
+	TicTacToe_GetLeaderboard_FullMethodName      = "/tic_tac_toe.TicTacToe/GetLeaderboard"
+	TicTacToe_GetUserStats_FullMethodName        = "/tic_tac_toe.TicTacToe/GetUserStats"
+	TicTacToe_ListUserGames_FullMethodName       = "/tic_tac_toe.TicTacToe/ListUserGames"
+	TicTacToe_GetHeadToHead_FullMethodName       = "/tic_tac_toe.TicTacToe/GetHeadToHead"
+	TicTacToe_GetGameReplay_FullMethodName       = "/tic_tac_toe.TicTacToe/GetGameReplay"
+	TicTacToe_ExportGame_FullMethodName          = "/tic_tac_toe.TicTacToe/ExportGame"
+	TicTacToe_ImportGames_FullMethodName         = "/tic_tac_toe.TicTacToe/ImportGames"
+	TicTacToe_AnalyzeGame_FullMethodName         = "/tic_tac_toe.TicTacToe/AnalyzeGame"
 )
 
 // TicTacToeClient is the client API for TicTacToe service.
@@ -29,6 +46,23 @@ const (
 type TicTacToeClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
+	JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error)
+	MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error)
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error)
+	WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchGameResponse], error)
+	ListAvailableGames(ctx context.Context, in *ListAvailableGamesRequest, opts ...grpc.CallOption) (*ListAvailableGamesResponse, error)
+	GetGameParticipants(ctx context.Context, in *GetGameParticipantsRequest, opts ...grpc.CallOption) (*GetGameParticipantsResponse, error)
+	FindMatch(ctx context.Context, in *FindMatchRequest, opts ...grpc.CallOption) (*FindMatchResponse, error)
+	CancelMatch(ctx context.Context, in *CancelMatchRequest, opts ...grpc.CallOption) (*CancelMatchResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*GetUserStatsResponse, error)
+	ListUserGames(ctx context.Context, in *ListUserGamesRequest, opts ...grpc.CallOption) (*ListUserGamesResponse, error)
+	GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error)
+	GetGameReplay(ctx context.Context, in *GetGameReplayRequest, opts ...grpc.CallOption) (*GetGameReplayResponse, error)
+	ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error)
+	ImportGames(ctx context.Context, in *ImportGamesRequest, opts ...grpc.CallOption) (*ImportGamesResponse, error)
+	AnalyzeGame(ctx context.Context, in *AnalyzeGameRequest, opts ...grpc.CallOption) (*AnalyzeGameResponse, error)
 }
 
 type ticTacToeClient struct {
@@ -59,12 +93,208 @@ func (c *ticTacToeClient) LoginUser(ctx context.Context, in *LoginUserRequest, o
 	return out, nil
 }
 
+func (c *ticTacToeClient) CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGameResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_CreateGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) JoinGame(ctx context.Context, in *JoinGameRequest, opts ...grpc.CallOption) (*JoinGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGameResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_JoinGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) MakeMove(ctx context.Context, in *MakeMoveRequest, opts ...grpc.CallOption) (*MakeMoveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MakeMoveResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_MakeMove_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*GetGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) WatchGame(ctx context.Context, in *WatchGameRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchGameResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TicTacToe_ServiceDesc.Streams[0], TicTacToe_WatchGame_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchGameRequest, WatchGameResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicTacToe_WatchGameClient = grpc.ServerStreamingClient[WatchGameResponse]
+
+func (c *ticTacToeClient) ListAvailableGames(ctx context.Context, in *ListAvailableGamesRequest, opts ...grpc.CallOption) (*ListAvailableGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAvailableGamesResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_ListAvailableGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetGameParticipants(ctx context.Context, in *GetGameParticipantsRequest, opts ...grpc.CallOption) (*GetGameParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameParticipantsResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetGameParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) FindMatch(ctx context.Context, in *FindMatchRequest, opts ...grpc.CallOption) (*FindMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindMatchResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_FindMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) CancelMatch(ctx context.Context, in *CancelMatchRequest, opts ...grpc.CallOption) (*CancelMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelMatchResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_CancelMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*GetUserStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserStatsResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetUserStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) ListUserGames(ctx context.Context, in *ListUserGamesRequest, opts ...grpc.CallOption) (*ListUserGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserGamesResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_ListUserGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetHeadToHead(ctx context.Context, in *GetHeadToHeadRequest, opts ...grpc.CallOption) (*GetHeadToHeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHeadToHeadResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetHeadToHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) GetGameReplay(ctx context.Context, in *GetGameReplayRequest, opts ...grpc.CallOption) (*GetGameReplayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGameReplayResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_GetGameReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) ExportGame(ctx context.Context, in *ExportGameRequest, opts ...grpc.CallOption) (*ExportGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGameResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_ExportGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) ImportGames(ctx context.Context, in *ImportGamesRequest, opts ...grpc.CallOption) (*ImportGamesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGamesResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_ImportGames_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticTacToeClient) AnalyzeGame(ctx context.Context, in *AnalyzeGameRequest, opts ...grpc.CallOption) (*AnalyzeGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeGameResponse)
+	err := c.cc.Invoke(ctx, TicTacToe_AnalyzeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicTacToeServer is the server API for TicTacToe service.
 // All implementations must embed UnimplementedTicTacToeServer
 // for forward compatibility.
 type TicTacToeServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
+	JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error)
+	MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error)
+	GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error)
+	WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[WatchGameResponse]) error
+	ListAvailableGames(context.Context, *ListAvailableGamesRequest) (*ListAvailableGamesResponse, error)
+	GetGameParticipants(context.Context, *GetGameParticipantsRequest) (*GetGameParticipantsResponse, error)
+	FindMatch(context.Context, *FindMatchRequest) (*FindMatchResponse, error)
+	CancelMatch(context.Context, *CancelMatchRequest) (*CancelMatchResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsResponse, error)
+	ListUserGames(context.Context, *ListUserGamesRequest) (*ListUserGamesResponse, error)
+	GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error)
+	GetGameReplay(context.Context, *GetGameReplayRequest) (*GetGameReplayResponse, error)
+	ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error)
+	ImportGames(context.Context, *ImportGamesRequest) (*ImportGamesResponse, error)
+	AnalyzeGame(context.Context, *AnalyzeGameRequest) (*AnalyzeGameResponse, error)
 	mustEmbedUnimplementedTicTacToeServer()
 }
 
@@ -81,6 +311,57 @@ func (UnimplementedTicTacToeServer) CreateUser(context.Context, *CreateUserReque
 func (UnimplementedTicTacToeServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
 }
+func (UnimplementedTicTacToeServer) CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGame not implemented")
+}
+func (UnimplementedTicTacToeServer) JoinGame(context.Context, *JoinGameRequest) (*JoinGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGame not implemented")
+}
+func (UnimplementedTicTacToeServer) MakeMove(context.Context, *MakeMoveRequest) (*MakeMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeMove not implemented")
+}
+func (UnimplementedTicTacToeServer) GetGame(context.Context, *GetGameRequest) (*GetGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedTicTacToeServer) WatchGame(*WatchGameRequest, grpc.ServerStreamingServer[WatchGameResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+func (UnimplementedTicTacToeServer) ListAvailableGames(context.Context, *ListAvailableGamesRequest) (*ListAvailableGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableGames not implemented")
+}
+func (UnimplementedTicTacToeServer) GetGameParticipants(context.Context, *GetGameParticipantsRequest) (*GetGameParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameParticipants not implemented")
+}
+func (UnimplementedTicTacToeServer) FindMatch(context.Context, *FindMatchRequest) (*FindMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMatch not implemented")
+}
+func (UnimplementedTicTacToeServer) CancelMatch(context.Context, *CancelMatchRequest) (*CancelMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMatch not implemented")
+}
+func (UnimplementedTicTacToeServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedTicTacToeServer) GetUserStats(context.Context, *GetUserStatsRequest) (*GetUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+}
+func (UnimplementedTicTacToeServer) ListUserGames(context.Context, *ListUserGamesRequest) (*ListUserGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserGames not implemented")
+}
+func (UnimplementedTicTacToeServer) GetHeadToHead(context.Context, *GetHeadToHeadRequest) (*GetHeadToHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadToHead not implemented")
+}
+func (UnimplementedTicTacToeServer) GetGameReplay(context.Context, *GetGameReplayRequest) (*GetGameReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameReplay not implemented")
+}
+func (UnimplementedTicTacToeServer) ExportGame(context.Context, *ExportGameRequest) (*ExportGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGame not implemented")
+}
+func (UnimplementedTicTacToeServer) ImportGames(context.Context, *ImportGamesRequest) (*ImportGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGames not implemented")
+}
+func (UnimplementedTicTacToeServer) AnalyzeGame(context.Context, *AnalyzeGameRequest) (*AnalyzeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeGame not implemented")
+}
 func (UnimplementedTicTacToeServer) mustEmbedUnimplementedTicTacToeServer() {}
 func (UnimplementedTicTacToeServer) testEmbeddedByValue()                   {}
 
@@ -138,6 +419,305 @@ func _TicTacToe_LoginUser_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicTacToe_CreateGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).CreateGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_CreateGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).CreateGame(ctx, req.(*CreateGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_JoinGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).JoinGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_JoinGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).JoinGame(ctx, req.(*JoinGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_MakeMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).MakeMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_MakeMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).MakeMove(ctx, req.(*MakeMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicTacToeServer).WatchGame(m, &grpc.GenericServerStream[WatchGameRequest, WatchGameResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicTacToe_WatchGameServer = grpc.ServerStreamingServer[WatchGameResponse]
+
+func _TicTacToe_ListAvailableGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).ListAvailableGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_ListAvailableGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).ListAvailableGames(ctx, req.(*ListAvailableGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetGameParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetGameParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetGameParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetGameParticipants(ctx, req.(*GetGameParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_FindMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).FindMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_FindMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).FindMatch(ctx, req.(*FindMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_CancelMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).CancelMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_CancelMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).CancelMatch(ctx, req.(*CancelMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_ListUserGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).ListUserGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_ListUserGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).ListUserGames(ctx, req.(*ListUserGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetHeadToHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHeadToHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetHeadToHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetHeadToHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetHeadToHead(ctx, req.(*GetHeadToHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_GetGameReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).GetGameReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_GetGameReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).GetGameReplay(ctx, req.(*GetGameReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_ExportGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).ExportGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_ExportGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).ExportGame(ctx, req.(*ExportGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_ImportGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).ImportGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_ImportGames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).ImportGames(ctx, req.(*ImportGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicTacToe_AnalyzeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicTacToeServer).AnalyzeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicTacToe_AnalyzeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicTacToeServer).AnalyzeGame(ctx, req.(*AnalyzeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TicTacToe_ServiceDesc is the grpc.ServiceDesc for TicTacToe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +733,77 @@ var TicTacToe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginUser",
 			Handler:    _TicTacToe_LoginUser_Handler,
 		},
+		{
+			MethodName: "CreateGame",
+			Handler:    _TicTacToe_CreateGame_Handler,
+		},
+		{
+			MethodName: "JoinGame",
+			Handler:    _TicTacToe_JoinGame_Handler,
+		},
+		{
+			MethodName: "MakeMove",
+			Handler:    _TicTacToe_MakeMove_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _TicTacToe_GetGame_Handler,
+		},
+		{
+			MethodName: "ListAvailableGames",
+			Handler:    _TicTacToe_ListAvailableGames_Handler,
+		},
+		{
+			MethodName: "GetGameParticipants",
+			Handler:    _TicTacToe_GetGameParticipants_Handler,
+		},
+		{
+			MethodName: "FindMatch",
+			Handler:    _TicTacToe_FindMatch_Handler,
+		},
+		{
+			MethodName: "CancelMatch",
+			Handler:    _TicTacToe_CancelMatch_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _TicTacToe_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetUserStats",
+			Handler:    _TicTacToe_GetUserStats_Handler,
+		},
+		{
+			MethodName: "ListUserGames",
+			Handler:    _TicTacToe_ListUserGames_Handler,
+		},
+		{
+			MethodName: "GetHeadToHead",
+			Handler:    _TicTacToe_GetHeadToHead_Handler,
+		},
+		{
+			MethodName: "GetGameReplay",
+			Handler:    _TicTacToe_GetGameReplay_Handler,
+		},
+		{
+			MethodName: "ExportGame",
+			Handler:    _TicTacToe_ExportGame_Handler,
+		},
+		{
+			MethodName: "ImportGames",
+			Handler:    _TicTacToe_ImportGames_Handler,
+		},
+		{
+			MethodName: "AnalyzeGame",
+			Handler:    _TicTacToe_AnalyzeGame_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _TicTacToe_WatchGame_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "tic_tac_toe.proto",
 }
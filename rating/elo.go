@@ -0,0 +1,12 @@
+package rating
+
+import "math"
+
+// Elo returns the updated ratings of two players after a game in which the
+// first player scored scoreA (Win, Draw or Loss)
+func Elo(ratingA float64, ratingB float64, scoreA float64) (float64, float64) {
+	// A difference of 400 points makes a win ten times as likely
+	expectedA := expectedScore((ratingA - ratingB) * math.Ln10 / 400)
+	delta := eloKFactor * (scoreA - expectedA)
+	return ratingA + delta, ratingB - delta
+}
@@ -0,0 +1,100 @@
+package rating
+
+import "math"
+
+const (
+	// glickoScale converts between the Glicko and Glicko-2 scales
+	glickoScale = 173.7178
+	// tau constrains how much the volatility can change per rating period
+	tau = 0.5
+	// convergenceTolerance ends the volatility iteration
+	convergenceTolerance = 0.000001
+)
+
+// Game is one result of a player within a rating period
+type Game struct {
+	Opponent Player
+	Score    float64 // Win, Draw or Loss
+}
+
+// Glicko2 returns the updated ratings of two players after a game in which
+// the first player scored scoreA (Win, Draw or Loss). Every game is treated as
+// its own rating period.
+func Glicko2(playerA Player, playerB Player, scoreA float64) (Player, Player) {
+	return Rate(playerA, []Game{{Opponent: playerB, Score: scoreA}}),
+		Rate(playerB, []Game{{Opponent: playerA, Score: 1 - scoreA}})
+}
+
+// Rate applies the games of one rating period to a player following
+// Glickman's "Example of the Glicko-2 system", steps 2 to 8
+func Rate(player Player, games []Game) Player {
+	mu := (player.Rating - DefaultRating) / glickoScale
+	phi := player.Deviation / glickoScale
+
+	// A player without games only grows less certain
+	if len(games) == 0 {
+		player.Deviation = glickoScale * math.Sqrt(phi*phi+player.Volatility*player.Volatility)
+		return player
+	}
+
+	var variance, improvement float64
+	for _, game := range games {
+		muOpponent := (game.Opponent.Rating - DefaultRating) / glickoScale
+		phiOpponent := game.Opponent.Deviation / glickoScale
+
+		g := 1 / math.Sqrt(1+3*phiOpponent*phiOpponent/(math.Pi*math.Pi))
+		expected := expectedScore(g * (mu - muOpponent))
+		variance += g * g * expected * (1 - expected)
+		improvement += g * (game.Score - expected)
+	}
+	v := 1 / variance
+	delta := v * improvement
+
+	sigma := newVolatility(phi, player.Volatility, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*improvement
+
+	return Player{
+		Rating:     glickoScale*newMu + DefaultRating,
+		Deviation:  glickoScale * newPhi,
+		Volatility: sigma,
+	}
+}
+
+// newVolatility solves for the updated volatility with the Illinois algorithm
+func newVolatility(phi float64, sigma float64, v float64, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		B = a - k*tau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > convergenceTolerance {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+
+	return math.Exp(A / 2)
+}
@@ -0,0 +1,53 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRateGlickmanExample(t *testing.T) {
+	// The worked example of Glickman's "Example of the Glicko-2 system"
+	player := Player{Rating: 1500, Deviation: 200, Volatility: DefaultVolatility}
+	games := []Game{
+		{Opponent: Player{Rating: 1400, Deviation: 30}, Score: Win},
+		{Opponent: Player{Rating: 1550, Deviation: 100}, Score: Loss},
+		{Opponent: Player{Rating: 1700, Deviation: 300}, Score: Loss},
+	}
+
+	got := Rate(player, games)
+	want := Player{Rating: 1464.06, Deviation: 151.52, Volatility: 0.05999}
+	if math.Abs(got.Rating-want.Rating) > 0.01 {
+		t.Errorf("rating %.2f, want %.2f", got.Rating, want.Rating)
+	}
+	if math.Abs(got.Deviation-want.Deviation) > 0.01 {
+		t.Errorf("deviation %.2f, want %.2f", got.Deviation, want.Deviation)
+	}
+	if math.Abs(got.Volatility-want.Volatility) > 0.00001 {
+		t.Errorf("volatility %.5f, want %.5f", got.Volatility, want.Volatility)
+	}
+}
+
+func TestRateWithoutGames(t *testing.T) {
+	player := Player{Rating: 1500, Deviation: 200, Volatility: DefaultVolatility}
+	got := Rate(player, nil)
+	if got.Rating != player.Rating || got.Deviation <= player.Deviation {
+		t.Errorf("got %+v, want the same rating with a wider deviation", got)
+	}
+}
+
+func TestGlicko2(t *testing.T) {
+	newcomer := Player{Rating: DefaultRating, Deviation: DefaultDeviation, Volatility: DefaultVolatility}
+
+	winner, loser := Glicko2(newcomer, newcomer, Win)
+	if winner.Rating <= DefaultRating || loser.Rating >= DefaultRating {
+		t.Errorf("win moves ratings to %.2f and %.2f", winner.Rating, loser.Rating)
+	}
+	if math.Abs((winner.Rating-DefaultRating)-(DefaultRating-loser.Rating)) > 1e-9 {
+		t.Errorf("equal players gain and lose different amounts: %.2f and %.2f", winner.Rating, loser.Rating)
+	}
+
+	a, b := Glicko2(newcomer, newcomer, Draw)
+	if a.Rating != DefaultRating || b.Rating != DefaultRating {
+		t.Errorf("draw between equals moves ratings to %.2f and %.2f", a.Rating, b.Rating)
+	}
+}
@@ -0,0 +1,33 @@
+package rating
+
+import "math"
+
+// Defaults every player starts with
+const (
+	DefaultRating     = 1500.0
+	DefaultDeviation  = 350.0
+	DefaultVolatility = 0.06
+)
+
+// eloKFactor bounds how many points a single game can move a rating
+const eloKFactor = 32.0
+
+// Scores of a game from a player's point of view
+const (
+	Loss = 0.0
+	Draw = 0.5
+	Win  = 1.0
+)
+
+// Player is a player's Glicko-2 rating on the familiar 1500-based scale
+type Player struct {
+	Rating     float64
+	Deviation  float64
+	Volatility float64
+}
+
+// expectedScore returns the score a player is expected to make against an
+// opponent, given their rating difference on the natural logarithmic scale
+func expectedScore(difference float64) float64 {
+	return 1 / (1 + math.Exp(-difference))
+}
@@ -0,0 +1,54 @@
+// Package rules implements the rules of tic-tac-toe independently of how
+// games are played or stored. Positions are values: playing a move returns a
+// new position and leaves the original untouched.
+package rules
+
+// Symbols placed on the board; an empty cell holds Empty
+const (
+	X     = "X"
+	O     = "O"
+	Empty = ""
+)
+
+// Board holds the symbol in every cell, numbered row by row from the top left
+// and, on three dimensional boards, layer by layer
+type Board []string
+
+// NewBoard returns an empty board for the given settings
+func NewBoard(settings Settings) Board {
+	return make(Board, settings.Cells())
+}
+
+// Full reports whether every cell is taken
+func (b Board) Full() bool {
+	for _, cell := range b {
+		if cell == Empty {
+			return false
+		}
+	}
+	return true
+}
+
+// count returns how many cells hold symbol
+func (b Board) count(symbol string) int {
+	n := 0
+	for _, cell := range b {
+		if cell == symbol {
+			n++
+		}
+	}
+	return n
+}
+
+// clone returns a copy of the board that can be changed freely
+func (b Board) clone() Board {
+	return append(Board(nil), b...)
+}
+
+// Opponent returns the symbol playing against symbol
+func Opponent(symbol string) string {
+	if symbol == X {
+		return O
+	}
+	return X
+}
@@ -0,0 +1,49 @@
+package rules
+
+// gravityPosition is a position of the gravity variant, where a move names a
+// column and the piece falls to the lowest empty cell in it. Lines are won as
+// in the m,n,k-game.
+type gravityPosition struct {
+	mnkPosition
+}
+
+// landingCell returns the lowest empty cell of a column, or -1 if it is full
+func (p gravityPosition) landingCell(column int) int {
+	for row := p.settings.Height - 1; row >= 0; row-- {
+		if cell := row*p.settings.Width + column; p.board[cell] == Empty {
+			return cell
+		}
+	}
+	return -1
+}
+
+func (p gravityPosition) LegalMoves() []int {
+	if p.outcome.Over() {
+		return nil
+	}
+
+	var columns []int
+	for column := 0; column < p.settings.Width; column++ {
+		if p.board[column] == Empty {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func (p gravityPosition) Play(column int) (Position, error) {
+	if p.outcome.Over() {
+		return p, ErrGameOver
+	}
+	if column < 0 || column >= p.settings.Width {
+		return p, ErrInvalidColumn
+	}
+
+	cell := p.landingCell(column)
+	if cell < 0 {
+		return p, ErrColumnFull
+	}
+
+	next, err := p.play(cell)
+	return gravityPosition{next}, err
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestGravityDrops(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []int
+		cells   []int // where each piece lands
+	}{
+		{"bottom row", []int{0, 6}, []int{35, 41}},
+		{"stacked", []int{3, 3, 3}, []int{38, 31, 24}},
+		{"side by side", []int{2, 3, 2}, []int{37, 38, 30}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			position := play(t, Gravity, tc.columns...)
+			board := position.Board()
+			for i, cell := range tc.cells {
+				want := X
+				if i%2 == 1 {
+					want = O
+				}
+				if board[cell] != want {
+					t.Errorf("piece %d: cell %d holds %q, want %q", i+1, cell, board[cell], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGravityOutcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []int
+		want    Outcome
+	}{
+		{"vertical four", []int{0, 1, 0, 1, 0, 1, 0}, XWins},
+		{"horizontal four", []int{0, 0, 1, 1, 2, 2, 3}, XWins},
+		{"diagonal four", []int{0, 1, 1, 2, 2, 3, 2, 3, 3, 6, 3}, XWins},
+		{"three", []int{0, 1, 0, 1, 0}, InProgress},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := play(t, Gravity, tc.columns...).Outcome(); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGravityIllegalMoves(t *testing.T) {
+	full := []int{0, 0, 0, 0, 0, 0}
+	if err := playErr(t, Gravity, 0, full...); !errors.Is(err, ErrColumnFull) {
+		t.Errorf("full column: got %v, want %v", err, ErrColumnFull)
+	}
+	if err := playErr(t, Gravity, 7); !errors.Is(err, ErrInvalidColumn) {
+		t.Errorf("column 7: got %v, want %v", err, ErrInvalidColumn)
+	}
+	if err := playErr(t, Gravity, 3, 0, 1, 0, 1, 0, 1, 0); !errors.Is(err, ErrGameOver) {
+		t.Errorf("after a win: got %v, want %v", err, ErrGameOver)
+	}
+
+	if got := play(t, Gravity, full...).LegalMoves(); !slices.Equal(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("LegalMoves() = %v, want every column but the full one", got)
+	}
+}
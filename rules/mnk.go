@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// mnkPosition is a position of the standard m,n,k-game, or of its misère
+// form in which the player who completes a line loses
+type mnkPosition struct {
+	settings Settings
+	board    Board
+	toMove   string
+	outcome  Outcome
+}
+
+func newMNKPosition(settings Settings) mnkPosition {
+	return mnkPosition{settings: settings, board: NewBoard(settings), toMove: X}
+}
+
+// PositionOf returns the standard or misère position reached on board. X
+// always moves first, so the symbol to move follows from how many moves each
+// side has made.
+func PositionOf(settings Settings, board Board) (Position, error) {
+	if settings.Variant != VariantStandard && settings.Variant != VariantMisere {
+		return nil, fmt.Errorf("cannot derive a %s position from its board", settings.Variant)
+	}
+	if len(board) != settings.Cells() {
+		return nil, fmt.Errorf("board has %d cells, want %d", len(board), settings.Cells())
+	}
+
+	p := mnkPosition{settings: settings, board: board.clone(), toMove: X}
+	if board.count(X) > board.count(O) {
+		p.toMove = O
+	}
+
+	for cell := range board {
+		if settings.completesLine(board, cell) {
+			p.outcome = p.lineOutcome(board[cell])
+			return p, nil
+		}
+	}
+	if board.Full() {
+		p.outcome = Draw
+	}
+	return p, nil
+}
+
+func (p mnkPosition) Settings() Settings {
+	return p.settings
+}
+
+func (p mnkPosition) Board() Board {
+	return p.board.clone()
+}
+
+func (p mnkPosition) Cell(cell int) string {
+	return p.board[cell]
+}
+
+func (p mnkPosition) ToMove() string {
+	return p.toMove
+}
+
+func (p mnkPosition) Outcome() Outcome {
+	return p.outcome
+}
+
+func (p mnkPosition) Details() interface{} {
+	return nil
+}
+
+func (p mnkPosition) Key(sym []int) string {
+	var sb strings.Builder
+	boardKey(&sb, p.toMove, p.board, sym)
+	return sb.String()
+}
+
+func (p mnkPosition) LegalMoves() []int {
+	if p.outcome.Over() {
+		return nil
+	}
+
+	moves := make([]int, 0, len(p.board))
+	for cell, symbol := range p.board {
+		if symbol == Empty {
+			moves = append(moves, cell)
+		}
+	}
+	return moves
+}
+
+func (p mnkPosition) Play(cell int) (Position, error) {
+	return p.play(cell)
+}
+
+// play places the symbol of the side to move on cell
+func (p mnkPosition) play(cell int) (mnkPosition, error) {
+	if p.outcome.Over() {
+		return p, ErrGameOver
+	}
+	if cell < 0 || cell >= len(p.board) {
+		return p, ErrInvalidCell
+	}
+	if p.board[cell] != Empty {
+		return p, ErrCellOccupied
+	}
+
+	next := p
+	next.board = p.board.clone()
+	next.board[cell] = p.toMove
+	next.toMove = Opponent(p.toMove)
+
+	// Only lines through the new symbol can have been completed
+	if p.settings.completesLine(next.board, cell) {
+		next.outcome = p.lineOutcome(p.toMove)
+	} else if next.board.Full() {
+		next.outcome = Draw
+	}
+	return next, nil
+}
+
+// lineOutcome returns the outcome of symbol completing a line
+func (p mnkPosition) lineOutcome(symbol string) Outcome {
+	if p.settings.Variant == VariantMisere {
+		return winOf(Opponent(symbol))
+	}
+	return winOf(symbol)
+}
@@ -0,0 +1,126 @@
+package rules
+
+import "strings"
+
+// NotaktoDetails is the state of a notakto game beyond its board
+type NotaktoDetails struct {
+	// DeadBoards marks the boards that hold three in a row
+	DeadBoards []bool `json:"deadBoards"`
+}
+
+// notaktoPosition is a position of notakto, in which both players place X on
+// one or more 3x3 boards laid side by side. A board with three in a row is
+// dead, and the player who kills the last live board loses.
+type notaktoPosition struct {
+	settings Settings
+	board    Board
+	toMove   string
+	dead     []bool
+	outcome  Outcome
+}
+
+func newNotaktoPosition(settings Settings) notaktoPosition {
+	return notaktoPosition{
+		settings: settings,
+		board:    NewBoard(settings),
+		toMove:   X,
+		dead:     make([]bool, settings.Width/3),
+	}
+}
+
+// boardCells returns the cells of one of the 3x3 boards in order
+func (p notaktoPosition) boardCells(index int) [9]int {
+	var cells [9]int
+	for i := range cells {
+		cells[i] = (i/3)*p.settings.Width + index*3 + i%3
+	}
+	return cells
+}
+
+func (p notaktoPosition) Settings() Settings {
+	return p.settings
+}
+
+func (p notaktoPosition) Board() Board {
+	return p.board.clone()
+}
+
+func (p notaktoPosition) Cell(cell int) string {
+	return p.board[cell]
+}
+
+func (p notaktoPosition) ToMove() string {
+	return p.toMove
+}
+
+func (p notaktoPosition) Outcome() Outcome {
+	return p.outcome
+}
+
+func (p notaktoPosition) Details() interface{} {
+	return NotaktoDetails{DeadBoards: append([]bool(nil), p.dead...)}
+}
+
+// Key needs only the board, since it tells which boards are dead
+func (p notaktoPosition) Key(sym []int) string {
+	var sb strings.Builder
+	boardKey(&sb, p.toMove, p.board, sym)
+	return sb.String()
+}
+
+func (p notaktoPosition) LegalMoves() []int {
+	if p.outcome.Over() {
+		return nil
+	}
+
+	var moves []int
+	for cell, symbol := range p.board {
+		if symbol == Empty && !p.dead[(cell%p.settings.Width)/3] {
+			moves = append(moves, cell)
+		}
+	}
+	return moves
+}
+
+func (p notaktoPosition) Play(cell int) (Position, error) {
+	if p.outcome.Over() {
+		return p, ErrGameOver
+	}
+	if cell < 0 || cell >= len(p.board) {
+		return p, ErrInvalidCell
+	}
+	if p.board[cell] != Empty {
+		return p, ErrCellOccupied
+	}
+
+	index := (cell % p.settings.Width) / 3
+	if p.dead[index] {
+		return p, ErrBoardClosed
+	}
+
+	next := p
+	next.board = p.board.clone()
+	next.board[cell] = X
+	next.toMove = Opponent(p.toMove)
+
+	local := make(Board, 9)
+	for i, c := range p.boardCells(index) {
+		local[i] = next.board[c]
+	}
+	inner := (cell/p.settings.Width)*3 + cell%3
+	if !Standard.completesLine(local, inner) {
+		return next, nil
+	}
+
+	next.dead = append([]bool(nil), p.dead...)
+	next.dead[index] = true
+	for _, dead := range next.dead {
+		if !dead {
+			return next, nil
+		}
+	}
+
+	// The move killed the last live board
+	next.outcome = winOf(next.toMove)
+	return next, nil
+}
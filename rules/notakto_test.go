@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNotakto(t *testing.T) {
+	double := Settings{Variant: VariantNotakto, Width: 6, Height: 3, WinLength: 3, Depth: 1}
+
+	tests := []struct {
+		name     string
+		settings Settings
+		moves    []int
+		want     Outcome
+		dead     []bool
+	}{
+		{"open board", Notakto, []int{0, 4}, InProgress, []bool{false}},
+		// Whoever completes a line on the last live board loses
+		{"second player completes a column", Notakto, []int{0, 1, 3, 6}, XWins, []bool{true}},
+		{"second player completes a row", Notakto, []int{0, 4, 1, 2}, XWins, []bool{true}},
+		{"first player completes a diagonal", Notakto, []int{0, 4, 8}, OWins, []bool{true}},
+		{"one of two boards dead", double, []int{0, 1, 2}, InProgress, []bool{true, false}},
+		{"both boards dead", double, []int{0, 1, 2, 3, 4, 5}, XWins, []bool{true, true}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			position := play(t, tc.settings, tc.moves...)
+			if got := position.Outcome(); got != tc.want {
+				t.Errorf("Outcome() = %d, want %d", got, tc.want)
+			}
+			details := position.Details().(NotaktoDetails)
+			if !slices.Equal(details.DeadBoards, tc.dead) {
+				t.Errorf("DeadBoards = %v, want %v", details.DeadBoards, tc.dead)
+			}
+		})
+	}
+}
+
+func TestNotaktoBothPlaceX(t *testing.T) {
+	position := play(t, Notakto, 0, 4)
+	if got := position.Cell(4); got != X {
+		t.Errorf("second player's cell holds %q, want %q", got, X)
+	}
+	if got := position.ToMove(); got != X {
+		t.Errorf("ToMove() = %q, want %q", got, X)
+	}
+}
+
+func TestNotaktoDeadBoardIsClosed(t *testing.T) {
+	double := Settings{Variant: VariantNotakto, Width: 6, Height: 3, WinLength: 3, Depth: 1}
+
+	// Cell 6 is on the first board, killed by the top row
+	if err := playErr(t, double, 6, 0, 1, 2); !errors.Is(err, ErrBoardClosed) {
+		t.Errorf("got %v, want %v", err, ErrBoardClosed)
+	}
+	for _, move := range play(t, double, 0, 1, 2).LegalMoves() {
+		if move%double.Width < 3 {
+			t.Errorf("LegalMoves() includes %d on the dead board", move)
+		}
+	}
+}
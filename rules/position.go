@@ -0,0 +1,95 @@
+package rules
+
+import "errors"
+
+// Errors returned when a move breaks the rules
+var (
+	ErrGameOver      = errors.New("game is already over")
+	ErrInvalidCell   = errors.New("invalid cell")
+	ErrCellOccupied  = errors.New("cell already occupied")
+	ErrWrongBoard    = errors.New("move must be played on the active sub-board")
+	ErrBoardClosed   = errors.New("sub-board is already decided")
+	ErrInvalidColumn = errors.New("invalid column")
+	ErrColumnFull    = errors.New("column is full")
+	ErrSpookyPair    = errors.New("spooky marks go in two different cells")
+	ErrCollapseFirst = errors.New("the cycle must be collapsed first")
+	ErrNoCycle       = errors.New("there is no cycle to collapse")
+	ErrNotInCycle    = errors.New("collapse must pick a cell of the mark that closed the cycle")
+)
+
+// Outcome is the result of a position
+type Outcome int
+
+const (
+	InProgress Outcome = iota
+	XWins
+	OWins
+	Draw
+)
+
+// Over reports whether the game has ended
+func (o Outcome) Over() bool {
+	return o != InProgress
+}
+
+// Winner returns the symbol that won, or Empty for a draw or unfinished game
+func (o Outcome) Winner() string {
+	switch o {
+	case XWins:
+		return X
+	case OWins:
+		return O
+	}
+	return Empty
+}
+
+// winOf returns the outcome of symbol completing a line
+func winOf(symbol string) Outcome {
+	if symbol == X {
+		return XWins
+	}
+	return OWins
+}
+
+// Position is an immutable game position of any variant
+type Position interface {
+	// Settings returns the variant and board dimensions
+	Settings() Settings
+	// Board returns a copy of the cells, numbered row by row from the top left
+	// and layer by layer
+	Board() Board
+	// Cell returns the symbol on a cell
+	Cell(cell int) string
+	// ToMove returns the side that plays the next move: X for the player who
+	// moved first, O for the other, even in variants where both place X
+	ToMove() string
+	// Outcome evaluates the position
+	Outcome() Outcome
+	// LegalMoves returns the moves that may be played, or none once the game
+	// is over. A move is a cell, a column when Settings().UsesColumns(), or a
+	// QuantumMove or CollapseMove when Settings().IsQuantum().
+	LegalMoves() []int
+	// Play returns the position after the side to move plays move
+	Play(move int) (Position, error)
+	// Details returns variant specific state for clients to render, or nil
+	Details() interface{}
+	// Key identifies the position after moving its cells by sym, one of the
+	// variant's Symmetries, or as it is when sym is nil. Positions with the
+	// same key play the same from here on.
+	Key(sym []int) string
+}
+
+// NewPosition returns the starting position of a game, with X to move
+func NewPosition(settings Settings) Position {
+	switch settings.Variant {
+	case VariantUltimate:
+		return newUltimatePosition()
+	case VariantNotakto:
+		return newNotaktoPosition(settings)
+	case VariantGravity:
+		return gravityPosition{newMNKPosition(settings)}
+	case VariantQuantum:
+		return newQuantumPosition(settings)
+	}
+	return newMNKPosition(settings)
+}
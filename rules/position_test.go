@@ -0,0 +1,166 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// play returns the position after moves from the start position of settings,
+// failing the test on an illegal move
+func play(t *testing.T, settings Settings, moves ...int) Position {
+	t.Helper()
+
+	position := NewPosition(settings)
+	for i, move := range moves {
+		next, err := position.Play(move)
+		if err != nil {
+			t.Fatalf("move %d (%d): %v", i+1, move, err)
+		}
+		position = next
+	}
+	return position
+}
+
+// playErr plays moves like play, then returns the error of playing last
+func playErr(t *testing.T, settings Settings, last int, moves ...int) error {
+	t.Helper()
+
+	_, err := play(t, settings, moves...).Play(last)
+	return err
+}
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		outcome Outcome
+		over    bool
+		winner  string
+	}{
+		{InProgress, false, Empty},
+		{XWins, true, X},
+		{OWins, true, O},
+		{Draw, true, Empty},
+	}
+
+	for _, tc := range tests {
+		if got := tc.outcome.Over(); got != tc.over {
+			t.Errorf("%d.Over() = %v, want %v", tc.outcome, got, tc.over)
+		}
+		if got := tc.outcome.Winner(); got != tc.winner {
+			t.Errorf("%d.Winner() = %q, want %q", tc.outcome, got, tc.winner)
+		}
+	}
+}
+
+func TestPositionOutcome(t *testing.T) {
+	gomoku := Settings{Variant: VariantStandard, Width: 15, Height: 15, WinLength: 5, Depth: 1}
+	wide := Settings{Variant: VariantStandard, Width: 5, Height: 3, WinLength: 4, Depth: 1}
+
+	tests := []struct {
+		name     string
+		settings Settings
+		moves    []int
+		want     Outcome
+	}{
+		{"empty", Standard, nil, InProgress},
+		{"row", Standard, []int{3, 0, 4, 1, 5}, XWins},
+		{"column", Standard, []int{0, 1, 3, 2, 6}, XWins},
+		{"diagonal", Standard, []int{0, 1, 4, 2, 8}, XWins},
+		{"anti-diagonal", Standard, []int{0, 2, 1, 4, 3, 6}, OWins},
+		{"draw", Standard, []int{0, 1, 2, 4, 3, 5, 7, 6, 8}, Draw},
+		{"win on the last cell", Standard, []int{0, 1, 2, 4, 3, 5, 7, 8, 6}, XWins},
+		{"two in a row", Standard, []int{0, 3, 1}, InProgress},
+		{"gomoku five", gomoku, []int{112, 0, 113, 1, 114, 2, 115, 3, 116}, XWins},
+		{"gomoku four", gomoku, []int{112, 0, 113, 1, 114, 2, 115}, InProgress},
+		{"gomoku diagonal", gomoku, []int{0, 1, 16, 2, 32, 3, 48, 4, 64}, XWins},
+		{"row does not wrap", gomoku, []int{13, 100, 14, 101, 15, 102, 16, 103, 17}, InProgress},
+		{"wide row", wide, []int{1, 5, 2, 6, 3, 7, 4}, XWins},
+		{"wide three", wide, []int{0, 5, 1, 6, 2}, InProgress},
+		{"misere line loses", Misere, []int{3, 0, 4, 1, 5}, OWins},
+		{"misere line by O loses", Misere, []int{0, 3, 1, 4, 8, 5}, XWins},
+		{"misere draw", Misere, []int{0, 1, 2, 4, 3, 5, 7, 6, 8}, Draw},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := play(t, tc.settings, tc.moves...).Outcome(); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIllegalMoves(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves []int
+		last  int
+		want  error
+	}{
+		{"occupied", []int{4}, 4, ErrCellOccupied},
+		{"negative", nil, -1, ErrInvalidCell},
+		{"off the board", nil, 9, ErrInvalidCell},
+		{"after a win", []int{0, 3, 1, 4, 2}, 8, ErrGameOver},
+		{"after a draw", []int{0, 1, 2, 4, 3, 5, 7, 6, 8}, 0, ErrGameOver},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := playErr(t, Standard, tc.last, tc.moves...); !errors.Is(err, tc.want) {
+				t.Errorf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestLegalMoves(t *testing.T) {
+	position := play(t, Standard, 4, 0)
+	if got := position.ToMove(); got != X {
+		t.Errorf("ToMove() = %q, want %q", got, X)
+	}
+	if got := position.LegalMoves(); !slices.Equal(got, []int{1, 2, 3, 5, 6, 7, 8}) {
+		t.Errorf("LegalMoves() = %v, want the seven empty cells", got)
+	}
+
+	won := play(t, Standard, 0, 3, 1, 4, 2)
+	if got := won.LegalMoves(); len(got) != 0 {
+		t.Errorf("LegalMoves() after a win = %v, want none", got)
+	}
+}
+
+func TestPlayLeavesPositionUntouched(t *testing.T) {
+	position := play(t, Standard, 4)
+	if _, err := position.Play(0); err != nil {
+		t.Fatal(err)
+	}
+	if got := position.Board()[0]; got != Empty {
+		t.Errorf("cell 0 of the earlier position holds %q", got)
+	}
+	if got := position.ToMove(); got != O {
+		t.Errorf("ToMove() of the earlier position = %q, want %q", got, O)
+	}
+}
+
+func TestPositionOf(t *testing.T) {
+	position, err := PositionOf(Standard, Board{X, O, Empty, Empty, X, Empty, Empty, Empty, Empty})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := position.ToMove(); got != O {
+		t.Errorf("ToMove() = %q, want %q", got, O)
+	}
+
+	next, err := position.Play(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Outcome() != InProgress {
+		t.Errorf("O on 8 gives %d, want %d", next.Outcome(), InProgress)
+	}
+}
+
+func TestPositionOfWrongSize(t *testing.T) {
+	if _, err := PositionOf(Settings{Variant: VariantStandard, Width: 4, Height: 4, WinLength: 3, Depth: 1}, NewBoard(Standard)); err == nil {
+		t.Error("a 3x3 board is accepted for 4x4 settings")
+	}
+}
@@ -0,0 +1,335 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QuantumMark is a spooky mark: a symbol placed in superposition on two cells
+// until a collapse decides which of them it takes
+type QuantumMark struct {
+	Symbol string `json:"symbol"`
+	// Move numbers the marks in the order they were placed, starting at 1
+	Move  int    `json:"move"`
+	Cells [2]int `json:"cells"`
+}
+
+// QuantumDetails is the state of a quantum game beyond its classical marks
+type QuantumDetails struct {
+	// Marks holds the spooky marks that have not collapsed yet
+	Marks []QuantumMark `json:"marks"`
+	// Subscripts holds the move number of the classical mark in every cell,
+	// or 0 for a cell without one
+	Subscripts []int `json:"subscripts"`
+	// Collapse holds the two cells of the mark that closed a cycle while the
+	// side to move must pick which of them it collapses into, and is empty
+	// otherwise
+	Collapse []int `json:"collapse,omitempty"`
+}
+
+// QuantumMove returns the move placing spooky marks on first and second. A
+// move with both cells equal places a classical mark, which is only allowed
+// on the last free cell. It returns -1 if a cell is off every board.
+func QuantumMove(first int, second int) int {
+	if first < 0 || first >= MaxCells || second < 0 || second >= MaxCells {
+		return -1
+	}
+	return first*MaxCells + second
+}
+
+// CollapseMove returns the move collapsing the mark that closed a cycle into
+// cell, or -1 if cell is off every board
+func CollapseMove(cell int) int {
+	if cell < 0 || cell >= MaxCells {
+		return -1
+	}
+	return MaxCells*MaxCells + cell
+}
+
+// ParseQuantumMove returns the cells of a QuantumMove, or the cell of a
+// CollapseMove together with collapse set. ok is false for any other number.
+func ParseQuantumMove(move int) (first int, second int, collapse bool, ok bool) {
+	switch {
+	case move < 0 || move >= MaxCells*MaxCells+MaxCells:
+		return 0, 0, false, false
+	case move >= MaxCells*MaxCells:
+		cell := move - MaxCells*MaxCells
+		return cell, cell, true, true
+	}
+	return move / MaxCells, move % MaxCells, false, true
+}
+
+// quantumPosition is a position of quantum tic-tac-toe. Every move places two
+// spooky marks of the same symbol in different cells. Marks that connect
+// cells already linked by other marks close a cycle, and the opponent of the
+// player who closed it picks which of its two cells the closing mark takes;
+// every mark in the cycle and hanging off it then collapses into a classical
+// mark. The first classical line wins. If a collapse completes lines for both
+// players, the line whose newest mark is older wins.
+type quantumPosition struct {
+	settings   Settings
+	lines      [][]int // shared by every position of a game
+	board      Board
+	subscripts []int
+	marks      []QuantumMark
+	cycle      int // index in marks of the mark that closed a cycle, or -1
+	placed     int
+	toMove     string
+	outcome    Outcome
+}
+
+func newQuantumPosition(settings Settings) quantumPosition {
+	return quantumPosition{
+		settings:   settings,
+		lines:      settings.lines(),
+		board:      NewBoard(settings),
+		subscripts: make([]int, settings.Cells()),
+		cycle:      -1,
+		toMove:     X,
+	}
+}
+
+func (p quantumPosition) Settings() Settings {
+	return p.settings
+}
+
+func (p quantumPosition) Board() Board {
+	return p.board.clone()
+}
+
+func (p quantumPosition) Cell(cell int) string {
+	return p.board[cell]
+}
+
+func (p quantumPosition) ToMove() string {
+	return p.toMove
+}
+
+func (p quantumPosition) Outcome() Outcome {
+	return p.outcome
+}
+
+func (p quantumPosition) Details() interface{} {
+	details := QuantumDetails{
+		Marks:      append([]QuantumMark{}, p.marks...),
+		Subscripts: append([]int(nil), p.subscripts...),
+	}
+	if p.cycle >= 0 {
+		cells := p.marks[p.cycle].Cells
+		details.Collapse = cells[:]
+	}
+	return details
+}
+
+func (p quantumPosition) Key(sym []int) string {
+	var sb strings.Builder
+	boardKey(&sb, p.toMove, p.board, sym)
+
+	subscripts := make([]int, len(p.subscripts))
+	for cell, subscript := range p.subscripts {
+		subscripts[moved(cell, sym)] = subscript
+	}
+	fmt.Fprint(&sb, subscripts)
+
+	// Marks stay in the order they were placed
+	for _, mark := range p.marks {
+		first, second := moved(mark.Cells[0], sym), moved(mark.Cells[1], sym)
+		fmt.Fprintf(&sb, " %s%d:%d-%d", mark.Symbol, mark.Move, min(first, second), max(first, second))
+	}
+	fmt.Fprintf(&sb, " %d", p.cycle)
+	return sb.String()
+}
+
+func (p quantumPosition) LegalMoves() []int {
+	if p.outcome.Over() {
+		return nil
+	}
+
+	if p.cycle >= 0 {
+		cells := p.marks[p.cycle].Cells
+		return []int{CollapseMove(cells[0]), CollapseMove(cells[1])}
+	}
+
+	free := p.freeCells()
+	if len(free) == 1 {
+		return []int{QuantumMove(free[0], free[0])}
+	}
+
+	var moves []int
+	for i, first := range free {
+		for _, second := range free[i+1:] {
+			moves = append(moves, QuantumMove(first, second))
+		}
+	}
+	return moves
+}
+
+// freeCells returns the cells without a classical mark
+func (p quantumPosition) freeCells() []int {
+	var free []int
+	for cell, symbol := range p.board {
+		if symbol == Empty {
+			free = append(free, cell)
+		}
+	}
+	return free
+}
+
+func (p quantumPosition) Play(move int) (Position, error) {
+	if p.outcome.Over() {
+		return p, ErrGameOver
+	}
+
+	first, second, collapse, ok := ParseQuantumMove(move)
+	if !ok || first >= len(p.board) || second >= len(p.board) {
+		return p, ErrInvalidCell
+	}
+
+	if collapse {
+		if p.cycle < 0 {
+			return p, ErrNoCycle
+		}
+		cells := p.marks[p.cycle].Cells
+		if first != cells[0] && first != cells[1] {
+			return p, ErrNotInCycle
+		}
+
+		next := p.clone()
+		next.collapse(p.cycle, first)
+		next.cycle = -1
+		next.outcome = next.evaluate()
+		return next, nil
+	}
+
+	if p.cycle >= 0 {
+		return p, ErrCollapseFirst
+	}
+	if p.board[first] != Empty || p.board[second] != Empty {
+		return p, ErrCellOccupied
+	}
+
+	next := p.clone()
+	next.placed++
+	next.toMove = Opponent(p.toMove)
+
+	if first == second {
+		// Only the last free cell takes a classical mark directly
+		if len(p.freeCells()) != 1 {
+			return p, ErrSpookyPair
+		}
+		next.board[first] = p.toMove
+		next.subscripts[first] = next.placed
+		next.outcome = next.evaluate()
+		return next, nil
+	}
+
+	if p.linked(first, second) {
+		next.cycle = len(p.marks)
+	}
+	next.marks = append(next.marks, QuantumMark{Symbol: p.toMove, Move: next.placed, Cells: [2]int{first, second}})
+	return next, nil
+}
+
+// clone returns a copy of the position that can be changed freely
+func (p quantumPosition) clone() quantumPosition {
+	next := p
+	next.board = p.board.clone()
+	next.subscripts = append([]int(nil), p.subscripts...)
+	next.marks = append([]QuantumMark(nil), p.marks...)
+	return next
+}
+
+// linked reports whether spooky marks already connect two cells
+func (p quantumPosition) linked(from int, to int) bool {
+	seen := map[int]bool{from: true}
+	queue := []int{from}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		if cell == to {
+			return true
+		}
+		for _, mark := range p.marks {
+			for i, end := range mark.Cells {
+				if end == cell && !seen[mark.Cells[1-i]] {
+					seen[mark.Cells[1-i]] = true
+					queue = append(queue, mark.Cells[1-i])
+				}
+			}
+		}
+	}
+	return false
+}
+
+// collapse turns the mark at index into a classical mark on cell. Every other
+// spooky mark on that cell is pushed to its other cell in turn, until the
+// whole cycle and the marks hanging off it are classical.
+func (p *quantumPosition) collapse(index int, cell int) {
+	type placement struct{ mark, cell int }
+
+	resolved := make(map[int]bool)
+	queue := []placement{{index, cell}}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if resolved[next.mark] || p.board[next.cell] != Empty {
+			continue
+		}
+
+		mark := p.marks[next.mark]
+		p.board[next.cell] = mark.Symbol
+		p.subscripts[next.cell] = mark.Move
+		resolved[next.mark] = true
+
+		for i, other := range p.marks {
+			if resolved[i] {
+				continue
+			}
+			for end, c := range other.Cells {
+				if c == next.cell {
+					queue = append(queue, placement{i, other.Cells[1-end]})
+				}
+			}
+		}
+	}
+
+	marks := p.marks[:0:0]
+	for i, mark := range p.marks {
+		if !resolved[i] {
+			marks = append(marks, mark)
+		}
+	}
+	p.marks = marks
+}
+
+// evaluate returns the outcome of the classical marks. Of several completed
+// lines the one whose newest mark was placed first wins.
+func (p quantumPosition) evaluate() Outcome {
+	winner, newest := Empty, 0
+	for _, line := range p.lines {
+		symbol := p.board[line[0]]
+		if symbol == Empty {
+			continue
+		}
+
+		complete, lineNewest := true, 0
+		for _, cell := range line {
+			if p.board[cell] != symbol {
+				complete = false
+				break
+			}
+			lineNewest = max(lineNewest, p.subscripts[cell])
+		}
+		if complete && (winner == Empty || lineNewest < newest) {
+			winner, newest = symbol, lineNewest
+		}
+	}
+
+	switch {
+	case winner != Empty:
+		return winOf(winner)
+	case p.board.Full():
+		return Draw
+	}
+	return InProgress
+}
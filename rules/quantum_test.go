@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestQuantumMoveEncoding(t *testing.T) {
+	tests := []struct {
+		move          int
+		first, second int
+		collapse      bool
+		ok            bool
+	}{
+		{QuantumMove(0, 8), 0, 8, false, true},
+		{QuantumMove(8, 8), 8, 8, false, true},
+		{CollapseMove(4), 4, 4, true, true},
+		{-1, 0, 0, false, false},
+		{CollapseMove(MaxCells-1) + 1, 0, 0, false, false},
+	}
+
+	for _, tc := range tests {
+		first, second, collapse, ok := ParseQuantumMove(tc.move)
+		if first != tc.first || second != tc.second || collapse != tc.collapse || ok != tc.ok {
+			t.Errorf("ParseQuantumMove(%d) = %d, %d, %v, %v, want %d, %d, %v, %v",
+				tc.move, first, second, collapse, ok, tc.first, tc.second, tc.collapse, tc.ok)
+		}
+	}
+}
+
+// cyclePosition returns the position after X links 0-1, O links 1-2 and X
+// closes the cycle with 0-2, so that O must collapse the last mark
+func cyclePosition(t *testing.T) Position {
+	t.Helper()
+	return play(t, Quantum, QuantumMove(0, 1), QuantumMove(1, 2), QuantumMove(0, 2))
+}
+
+func TestQuantumCycle(t *testing.T) {
+	position := play(t, Quantum, QuantumMove(0, 1), QuantumMove(1, 2))
+	if details := position.Details().(QuantumDetails); len(details.Collapse) != 0 {
+		t.Fatalf("a chain without a cycle asks for a collapse of %v", details.Collapse)
+	}
+
+	position = cyclePosition(t)
+	details := position.Details().(QuantumDetails)
+	if !slices.Equal(details.Collapse, []int{0, 2}) {
+		t.Errorf("Collapse = %v, want [0 2]", details.Collapse)
+	}
+	if got := position.ToMove(); got != O {
+		t.Errorf("ToMove() = %q, want %q", got, O)
+	}
+	if got := position.LegalMoves(); !slices.Equal(got, []int{CollapseMove(0), CollapseMove(2)}) {
+		t.Errorf("LegalMoves() = %v, want the two collapses", got)
+	}
+}
+
+func TestQuantumCollapse(t *testing.T) {
+	tests := []struct {
+		name       string
+		cell       int
+		board      Board
+		subscripts []int
+	}{
+		// The closing mark takes the chosen cell and pushes the others along
+		{"into 0", 0, Board{X, X, O}, []int{3, 1, 2}},
+		{"into 2", 2, Board{X, O, X}, []int{1, 2, 3}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := cyclePosition(t).Play(CollapseMove(tc.cell))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			board := position.Board()
+			if !slices.Equal(board[:3], tc.board) {
+				t.Errorf("board starts %v, want %v", board[:3], tc.board)
+			}
+			details := position.Details().(QuantumDetails)
+			if !slices.Equal(details.Subscripts[:3], tc.subscripts) {
+				t.Errorf("subscripts start %v, want %v", details.Subscripts[:3], tc.subscripts)
+			}
+			if len(details.Marks) != 0 || len(details.Collapse) != 0 {
+				t.Errorf("marks %v and collapse %v left after the collapse", details.Marks, details.Collapse)
+			}
+			// The player who collapsed goes on to move
+			if got := position.ToMove(); got != O {
+				t.Errorf("ToMove() = %q, want %q", got, O)
+			}
+		})
+	}
+}
+
+func TestQuantumIllegalMoves(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves []int
+		last  int
+		want  error
+	}{
+		{"classical mark with free cells left", nil, QuantumMove(4, 4), ErrSpookyPair},
+		{"collapse without a cycle", []int{QuantumMove(0, 1)}, CollapseMove(0), ErrNoCycle},
+		{"move before the collapse", []int{QuantumMove(0, 1), QuantumMove(1, 2), QuantumMove(0, 2)}, QuantumMove(3, 4), ErrCollapseFirst},
+		{"collapse off the cycle", []int{QuantumMove(0, 1), QuantumMove(1, 2), QuantumMove(0, 2)}, CollapseMove(1), ErrNotInCycle},
+		{"classical cell", []int{QuantumMove(0, 1), QuantumMove(1, 2), QuantumMove(0, 2), CollapseMove(0)}, QuantumMove(0, 5), ErrCellOccupied},
+		{"off the board", nil, QuantumMove(0, 9), ErrInvalidCell},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := playErr(t, Quantum, tc.last, tc.moves...); !errors.Is(err, tc.want) {
+				t.Errorf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestQuantumSimultaneousLines(t *testing.T) {
+	// O closes the cycle 2-3 with its sixth mark. X collapses it into 3,
+	// which completes X's right column with marks 1, 3 and 5 and O's left
+	// column with marks 2, 4 and 6. X's newest mark is older, so X wins.
+	position := play(t, Quantum,
+		QuantumMove(3, 5),
+		QuantumMove(0, 2),
+		QuantumMove(2, 3),
+		QuantumMove(6, 8),
+		QuantumMove(0, 8),
+		QuantumMove(2, 3),
+		CollapseMove(3),
+	)
+
+	want := Board{O, Empty, X, O, Empty, X, O, Empty, X}
+	if got := position.Board(); !slices.Equal(got, want) {
+		t.Fatalf("board %v, want %v", got, want)
+	}
+	if got := position.Outcome(); got != XWins {
+		t.Errorf("Outcome() = %d, want %d", got, XWins)
+	}
+}
@@ -0,0 +1,232 @@
+package rules
+
+import "fmt"
+
+// Variants of the game
+const (
+	VariantStandard = "standard"
+	VariantUltimate = "ultimate"
+	VariantMisere   = "misere"
+	VariantNotakto  = "notakto"
+	VariantGravity  = "gravity"
+	VariantCube     = "3d"
+	VariantQubic    = "qubic"
+	VariantQuantum  = "quantum"
+)
+
+// Limits on the board dimensions
+const (
+	MinSize = 3
+	MaxSize = 19
+)
+
+// MaxCells is the number of cells on the largest board
+const MaxCells = MaxSize * MaxSize
+
+// Settings describe the variant played and its board. Standard games are
+// m,n,k-games: a Width x Height board won by the first player to place
+// WinLength symbols in a row, column or diagonal. Boards of three dimensional
+// variants stack Depth such layers; every other board has a Depth of 1.
+type Settings struct {
+	Variant   string `json:"variant"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	WinLength int    `json:"winLength"`
+	Depth     int    `json:"depth"`
+}
+
+// Standard is classic 3x3 tic-tac-toe
+var Standard = Settings{Variant: VariantStandard, Width: 3, Height: 3, WinLength: 3, Depth: 1}
+
+// Ultimate is nine 3x3 sub-boards laid out on a 9x9 board
+var Ultimate = Settings{Variant: VariantUltimate, Width: 9, Height: 9, WinLength: 3, Depth: 1}
+
+// Misere is 3x3 tic-tac-toe in which completing a line loses
+var Misere = Settings{Variant: VariantMisere, Width: 3, Height: 3, WinLength: 3, Depth: 1}
+
+// Notakto is played on a single 3x3 board by default; wider boards hold
+// several 3x3 boards side by side
+var Notakto = Settings{Variant: VariantNotakto, Width: 3, Height: 3, WinLength: 3, Depth: 1}
+
+// Gravity drops pieces into the columns of a 7x6 board, four in a row to win
+var Gravity = Settings{Variant: VariantGravity, Width: 7, Height: 6, WinLength: 4, Depth: 1}
+
+// Cube is 3x3x3 tic-tac-toe with 49 winning lines
+var Cube = Settings{Variant: VariantCube, Width: 3, Height: 3, WinLength: 3, Depth: 3}
+
+// Qubic is 4x4x4 tic-tac-toe with 76 winning lines
+var Qubic = Settings{Variant: VariantQubic, Width: 4, Height: 4, WinLength: 4, Depth: 4}
+
+// Quantum is 3x3 quantum tic-tac-toe, in which moves place spooky marks
+var Quantum = Settings{Variant: VariantQuantum, Width: 3, Height: 3, WinLength: 3, Depth: 1}
+
+// MaxNotaktoBoards is the number of boards in the widest notakto game
+const MaxNotaktoBoards = 6
+
+// DefaultSettings returns the usual board of a variant, or Standard for an
+// unknown variant
+func DefaultSettings(variant string) Settings {
+	switch variant {
+	case VariantUltimate:
+		return Ultimate
+	case VariantMisere:
+		return Misere
+	case VariantNotakto:
+		return Notakto
+	case VariantGravity:
+		return Gravity
+	case VariantCube:
+		return Cube
+	case VariantQubic:
+		return Qubic
+	case VariantQuantum:
+		return Quantum
+	}
+	return Standard
+}
+
+// UsesColumns reports whether moves name a column rather than a cell
+func (s Settings) UsesColumns() bool {
+	return s.Variant == VariantGravity
+}
+
+// IsQuantum reports whether moves place spooky marks and collapse cycles
+func (s Settings) IsQuantum() bool {
+	return s.Variant == VariantQuantum
+}
+
+// Cells returns the number of cells on the board
+func (s Settings) Cells() int {
+	return s.Width * s.Height * s.Depth
+}
+
+// LayerCells returns the number of cells on one layer of the board
+func (s Settings) LayerCells() int {
+	return s.Width * s.Height
+}
+
+// Validate checks that the variant is known, that the board fits the size
+// limits and that a line of WinLength symbols fits on it
+func (s Settings) Validate() error {
+	switch s.Variant {
+	case VariantStandard, VariantMisere, VariantGravity, VariantNotakto, VariantUltimate, VariantQuantum:
+		if s.Depth != 1 {
+			return fmt.Errorf("%s is played on a flat board with a depth of 1", s.Variant)
+		}
+	case VariantCube, VariantQubic:
+		cube := DefaultSettings(s.Variant)
+		if s != cube {
+			return fmt.Errorf("%s is played on a %dx%dx%d board with a win length of %d", s.Variant, cube.Width, cube.Height, cube.Depth, cube.WinLength)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown variant %q", s.Variant)
+	}
+
+	switch s.Variant {
+	case VariantNotakto:
+		if s.Height != 3 || s.WinLength != 3 || s.Width%3 != 0 || s.Width < 3 || s.Width > 3*MaxNotaktoBoards {
+			return fmt.Errorf("notakto is played on 1 to %d 3x3 boards side by side with a win length of 3", MaxNotaktoBoards)
+		}
+		return nil
+	case VariantUltimate:
+		if s.Width != Ultimate.Width || s.Height != Ultimate.Height || s.WinLength != Ultimate.WinLength {
+			return fmt.Errorf("ultimate is played on a %dx%d board with a win length of %d", Ultimate.Width, Ultimate.Height, Ultimate.WinLength)
+		}
+		return nil
+	case VariantQuantum:
+		if s != Quantum {
+			return fmt.Errorf("quantum is played on a %dx%d board with a win length of %d", Quantum.Width, Quantum.Height, Quantum.WinLength)
+		}
+		return nil
+	}
+
+	if s.Width < MinSize || s.Width > MaxSize {
+		return fmt.Errorf("width must be between %d and %d", MinSize, MaxSize)
+	}
+	if s.Height < MinSize || s.Height > MaxSize {
+		return fmt.Errorf("height must be between %d and %d", MinSize, MaxSize)
+	}
+	if s.WinLength < MinSize || s.WinLength > max(s.Width, s.Height) {
+		return fmt.Errorf("win length must be between %d and %d", MinSize, max(s.Width, s.Height))
+	}
+	return nil
+}
+
+// Coordinates locate a cell by column, row and layer, counted from the top
+// left of the first layer
+type Coordinates struct {
+	X, Y, Z int
+}
+
+// CoordinatesOf returns the coordinates of a cell. Cells are numbered row by
+// row within a layer and layer by layer.
+func (s Settings) CoordinatesOf(cell int) Coordinates {
+	layer := cell % s.LayerCells()
+	return Coordinates{X: layer % s.Width, Y: layer / s.Width, Z: cell / s.LayerCells()}
+}
+
+// CellAt returns the cell at c, or -1 if c is off the board
+func (s Settings) CellAt(c Coordinates) int {
+	if c.X < 0 || c.X >= s.Width || c.Y < 0 || c.Y >= s.Height || c.Z < 0 || c.Z >= s.Depth {
+		return -1
+	}
+	return c.Z*s.LayerCells() + c.Y*s.Width + c.X
+}
+
+// directions holds one step along every line through a cell: rows, columns
+// and both diagonals within a layer, then the pillars and diagonals that cross
+// the layers. Steps across layers never stay on a flat board.
+var directions = []Coordinates{
+	{1, 0, 0}, {0, 1, 0}, {1, 1, 0}, {1, -1, 0},
+	{0, 0, 1}, {1, 0, 1}, {-1, 0, 1}, {0, 1, 1}, {0, -1, 1},
+	{1, 1, 1}, {1, -1, 1}, {-1, 1, 1}, {-1, -1, 1},
+}
+
+// completesLine reports whether the symbol on cell is part of WinLength equal
+// symbols in a line
+func (s Settings) completesLine(board Board, cell int) bool {
+	symbol := board[cell]
+	if symbol == Empty {
+		return false
+	}
+
+	c := s.CoordinatesOf(cell)
+	for _, d := range directions {
+		// Count matching symbols on both sides of the cell
+		n := 1
+		for _, sign := range []int{1, -1} {
+			next := Coordinates{c.X + sign*d.X, c.Y + sign*d.Y, c.Z + sign*d.Z}
+			for i := s.CellAt(next); i >= 0 && board[i] == symbol; i = s.CellAt(next) {
+				n++
+				next = Coordinates{next.X + sign*d.X, next.Y + sign*d.Y, next.Z + sign*d.Z}
+			}
+		}
+		if n >= s.WinLength {
+			return true
+		}
+	}
+	return false
+}
+
+// lines returns the cells of every run of WinLength cells in a straight line
+func (s Settings) lines() [][]int {
+	var lines [][]int
+	for cell := 0; cell < s.Cells(); cell++ {
+		c := s.CoordinatesOf(cell)
+		for _, d := range directions {
+			line := make([]int, 0, s.WinLength)
+			for i := 0; i < s.WinLength; i++ {
+				next := s.CellAt(Coordinates{c.X + i*d.X, c.Y + i*d.Y, c.Z + i*d.Z})
+				if next < 0 {
+					break
+				}
+				line = append(line, next)
+			}
+			if len(line) == s.WinLength {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
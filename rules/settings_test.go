@@ -0,0 +1,105 @@
+package rules
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		valid    bool
+	}{
+		{"standard", Standard, true},
+		{"ultimate", Ultimate, true},
+		{"misere", Misere, true},
+		{"notakto", Notakto, true},
+		{"gravity", Gravity, true},
+		{"cube", Cube, true},
+		{"qubic", Qubic, true},
+		{"quantum", Quantum, true},
+		{"qubic with a shorter line", Settings{Variant: VariantQubic, Width: 4, Height: 4, WinLength: 3, Depth: 4}, false},
+		{"layered standard board", Settings{Variant: VariantStandard, Width: 3, Height: 3, WinLength: 3, Depth: 3}, false},
+		{"notakto on three boards", Settings{Variant: VariantNotakto, Width: 9, Height: 3, WinLength: 3, Depth: 1}, true},
+		{"notakto on a partial board", Settings{Variant: VariantNotakto, Width: 4, Height: 3, WinLength: 3, Depth: 1}, false},
+		{"ultimate on a smaller board", Settings{Variant: VariantUltimate, Width: 6, Height: 6, WinLength: 3, Depth: 1}, false},
+		{"unknown variant", Settings{Variant: "hexagonal", Width: 3, Height: 3, WinLength: 3, Depth: 1}, false},
+		{"largest", Settings{Variant: VariantStandard, Width: MaxSize, Height: MaxSize, WinLength: 5, Depth: 1}, true},
+		{"too small", Settings{Variant: VariantStandard, Width: 2, Height: 3, WinLength: 3, Depth: 1}, false},
+		{"too large", Settings{Variant: VariantStandard, Width: MaxSize + 1, Height: 3, WinLength: 3, Depth: 1}, false},
+		{"win length beyond the board", Settings{Variant: VariantStandard, Width: 3, Height: 3, WinLength: 4, Depth: 1}, false},
+		{"win length too short", Settings{Variant: VariantStandard, Width: 5, Height: 5, WinLength: 2, Depth: 1}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settings.Validate()
+			if (err == nil) != tc.valid {
+				t.Errorf("Validate() = %v, want valid %v", err, tc.valid)
+			}
+		})
+	}
+}
+
+// countLines returns how many lines of WinLength cells fit on the board,
+// counting every start cell and direction once
+func countLines(s Settings) int {
+	n := 0
+	for cell := 0; cell < s.Cells(); cell++ {
+		start := s.CoordinatesOf(cell)
+		for _, d := range directions {
+			end := Coordinates{
+				X: start.X + d.X*(s.WinLength-1),
+				Y: start.Y + d.Y*(s.WinLength-1),
+				Z: start.Z + d.Z*(s.WinLength-1),
+			}
+			if s.CellAt(end) >= 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestLineCounts(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		want     int
+	}{
+		{"standard", Standard, 8},
+		{"4x4 three in a row", Settings{Variant: VariantStandard, Width: 4, Height: 4, WinLength: 3, Depth: 1}, 24},
+		{"cube", Cube, 49},
+		{"qubic", Qubic, 76},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := countLines(tc.settings); got != tc.want {
+				t.Errorf("got %d lines, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCubeOutcome(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings Settings
+		moves    []int
+		want     Outcome
+	}{
+		{"pillar", Cube, []int{4, 0, 13, 1, 22}, XWins},
+		{"space diagonal", Cube, []int{0, 1, 13, 2, 26}, XWins},
+		{"layer row", Cube, []int{9, 0, 10, 1, 11}, XWins},
+		{"diagonal across layers", Cube, []int{0, 1, 12, 2, 24}, XWins},
+		{"qubic pillar", Qubic, []int{0, 1, 16, 2, 32, 3, 48}, XWins},
+		{"qubic three", Qubic, []int{0, 1, 16, 2, 32}, InProgress},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := play(t, tc.settings, tc.moves...).Outcome(); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
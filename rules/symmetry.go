@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Symmetries returns the relabelings of cells that turn every position of
+// the variant into an equivalent one, starting with the identity; sym[cell]
+// is the cell that cell is moved to. Layers are rotated and reflected alike:
+// square layers have the eight symmetries of the square and other layers
+// four. Gravity boards only have their mirror image, since pieces fall down.
+func (s Settings) Symmetries() [][]int {
+	last := Coordinates{X: s.Width - 1, Y: s.Height - 1}
+	transforms := []func(x, y int) (int, int){
+		func(x, y int) (int, int) { return x, y },
+		func(x, y int) (int, int) { return last.X - x, y },
+	}
+	if !s.UsesColumns() {
+		transforms = append(transforms,
+			func(x, y int) (int, int) { return x, last.Y - y },
+			func(x, y int) (int, int) { return last.X - x, last.Y - y },
+		)
+		if s.Width == s.Height {
+			transforms = append(transforms,
+				func(x, y int) (int, int) { return y, x },
+				func(x, y int) (int, int) { return last.Y - y, x },
+				func(x, y int) (int, int) { return y, last.X - x },
+				func(x, y int) (int, int) { return last.Y - y, last.X - x },
+			)
+		}
+	}
+
+	symmetries := make([][]int, len(transforms))
+	for i, transform := range transforms {
+		sym := make([]int, s.Cells())
+		for cell := range sym {
+			c := s.CoordinatesOf(cell)
+			c.X, c.Y = transform(c.X, c.Y)
+			sym[cell] = s.CellAt(c)
+		}
+		symmetries[i] = sym
+	}
+	return symmetries
+}
+
+// CanonicalKey returns the smallest key of position under the symmetries of
+// its variant, so that positions equal up to symmetry share a key
+func CanonicalKey(position Position, symmetries [][]int) string {
+	var canonical string
+	for i, sym := range symmetries {
+		if key := position.Key(sym); i == 0 || key < canonical {
+			canonical = key
+		}
+	}
+	return canonical
+}
+
+// boardKey writes the side to move and the board with its cells moved by sym
+func boardKey(sb *strings.Builder, toMove string, board Board, sym []int) {
+	cells := make([]byte, len(board))
+	for cell, symbol := range board {
+		cells[moved(cell, sym)] = '.'
+		if symbol != Empty {
+			cells[moved(cell, sym)] = symbol[0]
+		}
+	}
+	fmt.Fprintf(sb, "%s%s", toMove, cells)
+}
+
+// moved returns where sym moves cell, or cell itself when sym is nil
+func moved(cell int, sym []int) int {
+	if sym == nil {
+		return cell
+	}
+	return sym[cell]
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SubBoardDraw marks a sub-board that filled up without a winner
+const SubBoardDraw = "draw"
+
+// AnyBoard is the active sub-board when a move may be played on any open one
+const AnyBoard = -1
+
+// UltimateDetails is the state of an ultimate game beyond its board
+type UltimateDetails struct {
+	// ActiveBoard is the sub-board the next move must be played on, or AnyBoard
+	ActiveBoard int `json:"activeBoard"`
+	// SubBoards holds the winner of every sub-board, SubBoardDraw, or Empty
+	// while it is still open
+	SubBoards []string `json:"subBoards"`
+}
+
+// ultimatePosition is a position of ultimate tic-tac-toe. Sub-boards are
+// numbered 0-8 like the cells of a 3x3 board; the cell a move is played on
+// within its sub-board decides the sub-board the opponent must play on next.
+type ultimatePosition struct {
+	board     Board
+	toMove    string
+	active    int
+	subBoards [9]string
+	outcome   Outcome
+}
+
+func newUltimatePosition() ultimatePosition {
+	return ultimatePosition{board: NewBoard(Ultimate), toMove: X, active: AnyBoard}
+}
+
+// subBoardOf returns the sub-board of a cell and the cell's index within it
+func subBoardOf(cell int) (subBoard int, inner int) {
+	row, column := cell/Ultimate.Width, cell%Ultimate.Width
+	return (row/3)*3 + column/3, (row%3)*3 + column%3
+}
+
+// subBoardCells returns the cells of a sub-board in order
+func subBoardCells(subBoard int) [9]int {
+	var cells [9]int
+	top, left := (subBoard/3)*3, (subBoard%3)*3
+	for i := range cells {
+		cells[i] = (top+i/3)*Ultimate.Width + left + i%3
+	}
+	return cells
+}
+
+func (p ultimatePosition) Settings() Settings {
+	return Ultimate
+}
+
+func (p ultimatePosition) Board() Board {
+	return p.board.clone()
+}
+
+func (p ultimatePosition) Cell(cell int) string {
+	return p.board[cell]
+}
+
+func (p ultimatePosition) ToMove() string {
+	return p.toMove
+}
+
+func (p ultimatePosition) Outcome() Outcome {
+	return p.outcome
+}
+
+func (p ultimatePosition) Details() interface{} {
+	return UltimateDetails{
+		ActiveBoard: p.active,
+		SubBoards:   p.subBoards[:],
+	}
+}
+
+func (p ultimatePosition) Key(sym []int) string {
+	var sb strings.Builder
+	boardKey(&sb, p.toMove, p.board, sym)
+	if p.active == AnyBoard {
+		sb.WriteString("*")
+	} else {
+		// The centre of a sub-board stays the centre of its image
+		active, _ := subBoardOf(moved(subBoardCells(p.active)[4], sym))
+		fmt.Fprintf(&sb, "%d", active)
+	}
+	return sb.String()
+}
+
+func (p ultimatePosition) LegalMoves() []int {
+	if p.outcome.Over() {
+		return nil
+	}
+
+	var moves []int
+	for subBoard, result := range p.subBoards {
+		if result != Empty || (p.active != AnyBoard && p.active != subBoard) {
+			continue
+		}
+		for _, cell := range subBoardCells(subBoard) {
+			if p.board[cell] == Empty {
+				moves = append(moves, cell)
+			}
+		}
+	}
+	return moves
+}
+
+func (p ultimatePosition) Play(cell int) (Position, error) {
+	if p.outcome.Over() {
+		return p, ErrGameOver
+	}
+	if cell < 0 || cell >= len(p.board) {
+		return p, ErrInvalidCell
+	}
+	if p.board[cell] != Empty {
+		return p, ErrCellOccupied
+	}
+
+	subBoard, inner := subBoardOf(cell)
+	if p.subBoards[subBoard] != Empty {
+		return p, ErrBoardClosed
+	}
+	if p.active != AnyBoard && p.active != subBoard {
+		return p, ErrWrongBoard
+	}
+
+	next := p
+	next.board = p.board.clone()
+	next.board[cell] = p.toMove
+	next.toMove = Opponent(p.toMove)
+
+	// Decide the sub-board the move was played on
+	local := make(Board, 9)
+	for i, c := range subBoardCells(subBoard) {
+		local[i] = next.board[c]
+	}
+	if Standard.completesLine(local, inner) {
+		next.subBoards[subBoard] = p.toMove
+	} else if local.Full() {
+		next.subBoards[subBoard] = SubBoardDraw
+	}
+
+	// Captured sub-boards form the board of the whole game
+	meta := make(Board, 9)
+	decided := 0
+	for i, result := range next.subBoards {
+		if result == X || result == O {
+			meta[i] = result
+		}
+		if result != Empty {
+			decided++
+		}
+	}
+	if meta[subBoard] != Empty && Standard.completesLine(meta, subBoard) {
+		next.outcome = winOf(p.toMove)
+	} else if decided == len(next.subBoards) {
+		next.outcome = Draw
+	}
+
+	// The opponent plays on the sub-board matching the cell just played,
+	// unless it is already decided
+	next.active = inner
+	if next.subBoards[inner] != Empty {
+		next.active = AnyBoard
+	}
+	return next, nil
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+)
+
+// ultimateCell returns the cell at inner within a sub-board
+func ultimateCell(subBoard int, inner int) int {
+	return subBoardCells(subBoard)[inner]
+}
+
+func TestUltimateRouting(t *testing.T) {
+	// X wins sub-board 4 with its middle row, then O's move on inner cell 4
+	// sends X to the decided sub-board, so X may play anywhere
+	moves := []struct {
+		subBoard, inner int
+		active          int // active sub-board after the move
+	}{
+		{0, 4, 4},
+		{4, 0, 0},
+		{0, 1, 1},
+		{1, 4, 4},
+		{4, 4, 4},
+		{4, 1, 1},
+		{1, 2, 2},
+		{2, 4, 4},
+		{4, 3, 3},
+		{3, 4, 4},
+		{4, 5, 5},
+		{5, 4, AnyBoard},
+	}
+
+	position := NewPosition(Ultimate)
+	for i, move := range moves {
+		next, err := position.Play(ultimateCell(move.subBoard, move.inner))
+		if err != nil {
+			t.Fatalf("move %d: %v", i+1, err)
+		}
+		position = next
+
+		details := position.Details().(UltimateDetails)
+		if details.ActiveBoard != move.active {
+			t.Errorf("move %d: active sub-board %d, want %d", i+1, details.ActiveBoard, move.active)
+		}
+	}
+
+	details := position.Details().(UltimateDetails)
+	if details.SubBoards[4] != X {
+		t.Errorf("sub-board 4 won by %q, want %q", details.SubBoards[4], X)
+	}
+	if _, err := position.Play(ultimateCell(4, 6)); !errors.Is(err, ErrBoardClosed) {
+		t.Errorf("move on the decided sub-board: got %v, want %v", err, ErrBoardClosed)
+	}
+	if _, err := position.Play(ultimateCell(8, 8)); err != nil {
+		t.Errorf("move on any open sub-board: %v", err)
+	}
+}
+
+func TestUltimateWrongBoard(t *testing.T) {
+	position := play(t, Ultimate, ultimateCell(0, 4))
+	if _, err := position.Play(ultimateCell(1, 0)); !errors.Is(err, ErrWrongBoard) {
+		t.Errorf("got %v, want %v", err, ErrWrongBoard)
+	}
+	for _, move := range position.LegalMoves() {
+		if subBoard, _ := subBoardOf(move); subBoard != 4 {
+			t.Errorf("LegalMoves() includes %d on sub-board %d", move, subBoard)
+		}
+	}
+}
+
+func TestUltimateDrawnSubBoard(t *testing.T) {
+	// X fills four cells of sub-board 4, each time sent back by O's move on
+	// the centre of another sub-board, then takes its centre, after which O
+	// fills the rest the same way. The sub-board ends
+	//
+	//	X O X
+	//	X X O
+	//	O X O
+	moves := [][2]int{
+		{4, 0}, {0, 4}, {4, 2}, {2, 4}, {4, 3}, {3, 4}, {4, 7}, {7, 4},
+		{4, 4}, {4, 1}, {1, 4}, {4, 5}, {5, 4}, {4, 6}, {6, 4}, {4, 8},
+	}
+	cells := make([]int, len(moves))
+	for i, move := range moves {
+		cells[i] = ultimateCell(move[0], move[1])
+	}
+	position := play(t, Ultimate, cells...)
+
+	details := position.Details().(UltimateDetails)
+	if details.SubBoards[4] != SubBoardDraw {
+		t.Errorf("sub-board 4 is %q, want %q", details.SubBoards[4], SubBoardDraw)
+	}
+	if details.ActiveBoard != 8 {
+		t.Errorf("active sub-board %d, want 8", details.ActiveBoard)
+	}
+
+	// Any move on the centre of a sub-board now sends the opponent to the
+	// full sub-board 4, so they may play anywhere
+	next, err := position.Play(ultimateCell(8, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := next.Details().(UltimateDetails).ActiveBoard; got != AnyBoard {
+		t.Errorf("sent to the full sub-board: active sub-board %d, want %d", got, AnyBoard)
+	}
+}
@@ -0,0 +1,272 @@
+// Package solver searches the game tree of any variant through rules.Position.
+// It runs negamax with alpha-beta pruning, deepening one ply at a time until
+// the game is solved or its time runs out, and remembers the positions it has
+// scored in a transposition table shared by every symmetric copy.
+package solver
+
+import (
+	"context"
+	"main/rules"
+	"math"
+	"sort"
+	"time"
+)
+
+// Win is the score of a won position. Quicker wins score higher: a win n
+// plies away scores Win - n, and a loss n plies away -(Win - n).
+const Win = 1 << 20
+
+// maxEntries bounds the transposition table; once it is full, new positions
+// are no longer remembered
+const maxEntries = 1 << 20
+
+// Options limit a search
+type Options struct {
+	// MaxDepth is the deepest search in plies, or 0 to deepen until the game
+	// is solved or TimeBudget runs out
+	MaxDepth int
+	// TimeBudget is how long to keep deepening, or 0 for no limit. The first
+	// ply is always searched in full, even once the context is done.
+	TimeBudget time.Duration
+}
+
+// MoveScore is the score of a legal move from the point of view of the side
+// playing it
+type MoveScore struct {
+	Move  int
+	Score int
+}
+
+// Result is the outcome of a search
+type Result struct {
+	// Best is the highest scoring move; ties go to the first legal move
+	Best int
+	// Score is the score of Best from the point of view of the side to move
+	Score int
+	// Moves holds every legal move, best first
+	Moves []MoveScore
+	// Depth is the number of plies of the deepest completed iteration
+	Depth int
+	// Exact reports whether the search reached the end of every line, so the
+	// scores are the game's true values rather than estimates
+	Exact bool
+	// Nodes counts the positions visited
+	Nodes int
+}
+
+// IsWin reports whether a score is a forced win
+func IsWin(score int) bool {
+	return score > Win/2
+}
+
+// IsLoss reports whether a score is a forced loss
+func IsLoss(score int) bool {
+	return score < -Win/2
+}
+
+// Search scores every legal move of position, which must not be over. Scores
+// are 0 for lines not decided within the depth searched. Deepening stops when
+// ctx is done as well as when TimeBudget runs out.
+func Search(ctx context.Context, position rules.Position, options Options) Result {
+	if options.TimeBudget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.TimeBudget)
+		defer cancel()
+	}
+	s := &searcher{
+		ctx:        ctx,
+		symmetries: position.Settings().Symmetries(),
+		table:      make(map[string]entry),
+	}
+
+	var result Result
+	for depth := 1; options.MaxDepth == 0 || depth <= options.MaxDepth; depth++ {
+		moves, complete, ok := s.root(position, depth)
+		if !ok {
+			break
+		}
+
+		result = Result{
+			Best:  moves[0].Move,
+			Score: moves[0].Score,
+			Moves: moves,
+			Depth: depth,
+			Exact: complete,
+		}
+		if complete {
+			break
+		}
+	}
+
+	result.Nodes = s.nodes
+	return result
+}
+
+// bound tells how a stored score relates to the true score of a position
+type bound int
+
+const (
+	exact bound = iota
+	lower
+	upper
+)
+
+// entry is a position scored by an earlier search
+type entry struct {
+	score int // relative to the position, see toTable
+	depth int
+	bound bound
+}
+
+type searcher struct {
+	ctx        context.Context
+	symmetries [][]int
+	table      map[string]entry
+	nodes      int
+	// horizon is set when a line was cut off at the depth limit, so the
+	// scores found are estimates
+	horizon bool
+	// mustFinish is set while searching the first ply, which ignores the
+	// context so that a result is always available
+	mustFinish bool
+	aborted    bool
+}
+
+// root scores every move of position searching depth plies ahead. It reports
+// whether no line was cut off at the depth limit, and fails if time ran out.
+func (s *searcher) root(position rules.Position, depth int) ([]MoveScore, bool, bool) {
+	s.horizon = false
+	s.mustFinish = depth == 1
+
+	var moves []MoveScore
+	for _, move := range position.LegalMoves() {
+		next, err := position.Play(move)
+		if err != nil {
+			continue
+		}
+
+		// Every root move gets a full window so that its score is exact
+		score := s.child(position, next, depth-1, 1, -Win-1, Win+1)
+		if s.aborted {
+			return nil, false, false
+		}
+		moves = append(moves, MoveScore{Move: move, Score: score})
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].Score > moves[j].Score })
+	return moves, !s.horizon, true
+}
+
+// child returns the score of next, reached from position, from the point of
+// view of the side to move in position. Most moves hand the turn over, but
+// some variants let the same side move again.
+func (s *searcher) child(position rules.Position, next rules.Position, depth int, ply int, alpha int, beta int) int {
+	if next.ToMove() == position.ToMove() {
+		return s.negamax(next, depth, ply, alpha, beta)
+	}
+	return -s.negamax(next, depth, ply, -beta, -alpha)
+}
+
+// negamax returns the score of position from the point of view of its side
+// to move, exact if it lies within alpha and beta and otherwise a bound
+func (s *searcher) negamax(position rules.Position, depth int, ply int, alpha int, beta int) int {
+	s.nodes++
+	if s.nodes%1024 == 0 && !s.mustFinish && s.ctx.Err() != nil {
+		s.aborted = true
+	}
+	if s.aborted {
+		return 0
+	}
+
+	if outcome := position.Outcome(); outcome.Over() {
+		switch outcome.Winner() {
+		case rules.Empty:
+			return 0
+		case position.ToMove():
+			return Win - ply
+		}
+		return ply - Win
+	}
+	if depth == 0 {
+		s.horizon = true
+		return 0
+	}
+
+	key := rules.CanonicalKey(position, s.symmetries)
+	if stored, ok := s.table[key]; ok && stored.depth >= depth {
+		score := fromTable(stored.score, ply)
+		switch {
+		case stored.bound == exact,
+			stored.bound == lower && score >= beta,
+			stored.bound == upper && score <= alpha:
+			if stored.depth != math.MaxInt {
+				s.horizon = true
+			}
+			return score
+		}
+	}
+
+	// Tell whether this subtree reaches the horizon apart from the rest
+	horizon := s.horizon
+	s.horizon = false
+
+	best, originalAlpha := -Win-1, alpha
+	for _, move := range position.LegalMoves() {
+		next, err := position.Play(move)
+		if err != nil {
+			continue
+		}
+
+		score := s.child(position, next, depth-1, ply+1, alpha, beta)
+		best = max(best, score)
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	complete := !s.horizon
+	s.horizon = s.horizon || horizon
+	if s.aborted {
+		return 0
+	}
+
+	if len(s.table) < maxEntries {
+		stored := entry{score: toTable(best, ply), depth: depth, bound: exact}
+		switch {
+		case best <= originalAlpha:
+			stored.bound = upper
+		case best >= beta:
+			stored.bound = lower
+		}
+		// A subtree searched to the end holds at any depth
+		if complete {
+			stored.depth = math.MaxInt
+		}
+		s.table[key] = stored
+	}
+	return best
+}
+
+// toTable makes a win or loss score relative to the position it is stored
+// for, so that it stays right when the position is reached at another ply
+func toTable(score int, ply int) int {
+	switch {
+	case IsWin(score):
+		return score + ply
+	case IsLoss(score):
+		return score - ply
+	}
+	return score
+}
+
+// fromTable undoes toTable for a position reached at ply
+func fromTable(score int, ply int) int {
+	switch {
+	case IsWin(score):
+		return score - ply
+	case IsLoss(score):
+		return score + ply
+	}
+	return score
+}
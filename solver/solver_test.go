@@ -0,0 +1,121 @@
+package solver
+
+import (
+	"context"
+	"main/rules"
+	"testing"
+)
+
+func TestSearchSolvesStartPositions(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings rules.Settings
+		check    func(score int) bool
+		want     string
+	}{
+		{"standard", rules.Standard, func(score int) bool { return score == 0 }, "a draw"},
+		{"misere", rules.Misere, func(score int) bool { return score == 0 }, "a draw"},
+		{"notakto", rules.Notakto, IsWin, "a first player win"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Search(context.Background(), rules.NewPosition(tc.settings), Options{})
+			if !result.Exact {
+				t.Errorf("search of depth %d is not exact", result.Depth)
+			}
+			if !tc.check(result.Score) {
+				t.Errorf("score %d, want %s", result.Score, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchFindsForcedMoves(t *testing.T) {
+	tests := []struct {
+		name  string
+		board rules.Board
+		best  int
+		win   bool
+	}{
+		// X to move completes the top row
+		{"win", rules.Board{"X", "X", "", "O", "O", "", "", "", ""}, 2, true},
+		// O to move must block the diagonal
+		{"block", rules.Board{"X", "", "", "", "X", "", "", "", ""}, 8, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			position, err := rules.PositionOf(rules.Standard, tc.board)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result := Search(context.Background(), position, Options{})
+			if result.Best != tc.best {
+				t.Errorf("best move %d, want %d", result.Best, tc.best)
+			}
+			if IsWin(result.Score) != tc.win {
+				t.Errorf("score %d, want win %v", result.Score, tc.win)
+			}
+		})
+	}
+}
+
+// mirror reflects a 3x3 board left to right
+func mirror(board rules.Board) rules.Board {
+	mirrored := make(rules.Board, len(board))
+	for cell, symbol := range board {
+		mirrored[cell/3*3+2-cell%3] = symbol
+	}
+	return mirrored
+}
+
+func TestMirroredPositionsShareTheTable(t *testing.T) {
+	board := rules.Board{"X", "", "", "", "O", "X", "", "", ""}
+	position, err := rules.PositionOf(rules.Standard, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mirrored, err := rules.PositionOf(rules.Standard, mirror(board))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &searcher{
+		symmetries: rules.Standard.Symmetries(),
+		table:      make(map[string]entry),
+	}
+	score := s.negamax(position, rules.Standard.Cells(), 0, -Win-1, Win+1)
+
+	// The mirrored copy is answered from the table without a search
+	nodes := s.nodes
+	if got := s.negamax(mirrored, rules.Standard.Cells(), 0, -Win-1, Win+1); got != score {
+		t.Errorf("mirrored score %d, want %d", got, score)
+	}
+	if visited := s.nodes - nodes; visited != 1 {
+		t.Errorf("mirrored position visited %d nodes, want 1", visited)
+	}
+
+	// Fresh searches agree move for mirrored move
+	result, mirroredResult := Search(context.Background(), position, Options{}), Search(context.Background(), mirrored, Options{})
+	if result.Score != mirroredResult.Score {
+		t.Errorf("Search scores %d and %d for mirrored positions", result.Score, mirroredResult.Score)
+	}
+	scores := make(map[int]int)
+	for _, move := range mirroredResult.Moves {
+		scores[move.Move] = move.Score
+	}
+	for _, move := range result.Moves {
+		reflected := move.Move/3*3 + 2 - move.Move%3
+		if scores[reflected] != move.Score {
+			t.Errorf("move %d scores %d but its mirror %d scores %d", move.Move, move.Score, reflected, scores[reflected])
+		}
+	}
+}
+
+func TestSearchRespectsMaxDepth(t *testing.T) {
+	result := Search(context.Background(), rules.NewPosition(rules.Standard), Options{MaxDepth: 2})
+	if result.Depth != 2 || result.Exact {
+		t.Errorf("depth %d exact %v, want depth 2 and an estimate", result.Depth, result.Exact)
+	}
+}
@@ -14,6 +14,9 @@ type Config struct {
 	WebSocketServerAddress string        `mapstructure:"WEBSOCKET_SERVER_ADDRESS"`
 	TokenSymmetricKey      string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
 	AccessTokenDuration    time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	MatchmakingTimeout     time.Duration `mapstructure:"MATCHMAKING_TIMEOUT"`
+	ReplayInterval         time.Duration `mapstructure:"REPLAY_INTERVAL"`
+	BotMoveDelay           time.Duration `mapstructure:"BOT_MOVE_DELAY"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
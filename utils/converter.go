@@ -3,13 +3,205 @@ package utils
 import (
 	db "main/db/sqlc"
 	"main/pb"
+	"main/rules"
+	"main/ws"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConvertUser(user db.User) *pb.User {
 	return &pb.User{
-		Username:  user.Username,
-		CreatedAt: timestamppb.New(user.CreatedAt),
+		Username:        user.Username,
+		CreatedAt:       timestamppb.New(user.CreatedAt),
+		Rating:          user.Rating,
+		RatingDeviation: user.RatingDeviation,
 	}
 }
+
+func ConvertGame(gameID string, game *ws.GameState) *pb.Game {
+	converted := &pb.Game{
+		GameId:     gameID,
+		Board:      game.Board[:],
+		Players:    game.Players,
+		Turn:       game.Turn,
+		Winner:     game.Winner,
+		GameOver:   game.GameOver,
+		GameReady:  game.GameReady,
+		Moves:      int32(game.Moves),
+		Settings:   ConvertGameSettings(game.Settings),
+		Rated:      game.Rated,
+		Hints:      game.Hints,
+		Reason:     game.Reason,
+		ResignedBy: game.ResignedBy,
+		DrawOffer:  game.DrawOffer,
+	}
+
+	switch details := game.Details.(type) {
+	case rules.UltimateDetails:
+		converted.Ultimate = &pb.UltimateDetails{
+			ActiveBoard: int32(details.ActiveBoard),
+			SubBoards:   details.SubBoards,
+		}
+	case rules.NotaktoDetails:
+		converted.Notakto = &pb.NotaktoDetails{
+			DeadBoards: details.DeadBoards,
+		}
+	case rules.QuantumDetails:
+		converted.Quantum = convertQuantumDetails(details)
+	}
+	return converted
+}
+
+func convertQuantumDetails(details rules.QuantumDetails) *pb.QuantumDetails {
+	marks := make([]*pb.QuantumMark, len(details.Marks))
+	for i, mark := range details.Marks {
+		marks[i] = &pb.QuantumMark{
+			Symbol: mark.Symbol,
+			Move:   int32(mark.Move),
+			Cells:  []int32{int32(mark.Cells[0]), int32(mark.Cells[1])},
+		}
+	}
+
+	return &pb.QuantumDetails{
+		Marks:      marks,
+		Subscripts: int32s(details.Subscripts),
+		Collapse:   int32s(details.Collapse),
+	}
+}
+
+func int32s(values []int) []int32 {
+	converted := make([]int32, len(values))
+	for i, value := range values {
+		converted[i] = int32(value)
+	}
+	return converted
+}
+
+func ConvertGameSettings(settings rules.Settings) *pb.GameSettings {
+	return &pb.GameSettings{
+		Variant:   settings.Variant,
+		Width:     int32(settings.Width),
+		Height:    int32(settings.Height),
+		WinLength: int32(settings.WinLength),
+		Depth:     int32(settings.Depth),
+	}
+}
+
+func ConvertGameReplay(replay *ws.GameReplay) *pb.GameReplay {
+	moves := make([]*pb.ReplayMove, len(replay.Steps))
+	for i, step := range replay.Steps {
+		moves[i] = &pb.ReplayMove{
+			MoveNumber: int32(step.MoveNumber),
+			Username:   step.PlayerID,
+			Symbol:     step.Symbol,
+			Position:   int32(step.Position),
+			Board:      step.Board[:],
+			PlayedAt:   timestamppb.New(step.PlayedAt),
+		}
+	}
+
+	return &pb.GameReplay{
+		GameId:     replay.GameID,
+		Settings:   ConvertGameSettings(replay.Settings),
+		Players:    replay.Players,
+		Winner:     replay.Winner,
+		Reason:     replay.Reason,
+		CreatedAt:  timestamppb.New(replay.CreatedAt),
+		FinishedAt: timestamppb.New(replay.FinishedAt),
+		Moves:      moves,
+	}
+}
+
+func ConvertGameAnalysis(analysis *ws.GameAnalysis) *pb.GameAnalysis {
+	moves := make([]*pb.MoveAnalysis, len(analysis.Moves))
+	for i, move := range analysis.Moves {
+		moves[i] = &pb.MoveAnalysis{
+			MoveNumber:   int32(move.MoveNumber),
+			Username:     move.PlayerID,
+			Position:     int32(move.Position),
+			Notation:     move.Notation,
+			Label:        move.Label,
+			Score:        move.Score,
+			BestMove:     int32(move.BestMove),
+			BestNotation: move.BestNotation,
+			BestScore:    move.BestScore,
+			Exact:        move.Exact,
+		}
+	}
+
+	return &pb.GameAnalysis{
+		GameId:       analysis.GameID,
+		Settings:     ConvertGameSettings(analysis.Settings),
+		Players:      analysis.Players,
+		Winner:       analysis.Winner,
+		Moves:        moves,
+		DecisiveMove: int32(analysis.DecisiveMove),
+	}
+}
+
+func ConvertGameSummary(game db.ListAvailableGamesRow) *pb.GameSummary {
+	return &pb.GameSummary{
+		GameId:       game.Code,
+		HostUsername: game.HostUsername,
+		CreatedAt:    timestamppb.New(game.CreatedAt),
+		Settings: &pb.GameSettings{
+			Variant:   game.Variant,
+			Width:     game.Width,
+			Height:    game.Height,
+			WinLength: game.WinLength,
+			Depth:     game.Depth,
+		},
+	}
+}
+
+func ConvertLeaderboardEntry(entry db.ListLeaderboardRow) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		Rank:        entry.Rank,
+		Username:    entry.Username,
+		Rating:      entry.Rating,
+		GamesPlayed: entry.GamesPlayed,
+		Wins:        entry.Wins,
+		Losses:      entry.Losses,
+		Draws:       entry.Draws,
+		WinRate:     entry.WinRate,
+	}
+}
+
+func ConvertUserStats(username string, stats db.GetUserStatsRow) *pb.UserStats {
+	userStats := &pb.UserStats{
+		Username:    username,
+		GamesPlayed: stats.GamesPlayed,
+		Wins:        stats.Wins,
+		Losses:      stats.Losses,
+		Draws:       stats.Draws,
+	}
+	if stats.GamesPlayed > 0 {
+		userStats.WinRate = float64(stats.Wins) / float64(stats.GamesPlayed)
+	}
+	return userStats
+}
+
+func ConvertUserGame(game db.ListUserGamesRow) *pb.UserGame {
+	userGame := &pb.UserGame{
+		GameId:    game.Code,
+		Opponent:  game.OpponentUsername,
+		Result:    ConvertGameResult(game.Result),
+		CreatedAt: timestamppb.New(game.CreatedAt),
+	}
+	if game.FinishedAt.Valid {
+		userGame.FinishedAt = timestamppb.New(game.FinishedAt.Time)
+	}
+	return userGame
+}
+
+func ConvertGameResult(result string) pb.GameResult {
+	switch result {
+	case db.GameResultWin:
+		return pb.GameResult_GAME_RESULT_WIN
+	case db.GameResultLoss:
+		return pb.GameResult_GAME_RESULT_LOSS
+	case db.GameResultDraw:
+		return pb.GameResult_GAME_RESULT_DRAW
+	}
+	return pb.GameResult_GAME_RESULT_UNSPECIFIED
+}
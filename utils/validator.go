@@ -2,12 +2,12 @@ package utils
 
 import (
 	"fmt"
+	"main/rules"
+	"main/ws"
 	"regexp"
 )
 
-var (
-	isValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
-)
+var isValidUsername = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
 
 func ValidateString(value string, minLength int, maxLength int) error {
 	n := len(value)
@@ -17,6 +17,13 @@ func ValidateString(value string, minLength int, maxLength int) error {
 	return nil
 }
 
+func ValidateNumber(value int32, min int32, max int32) error {
+	if value < min || value > max {
+		return fmt.Errorf("must be between %d and %d", min, max)
+	}
+	return nil
+}
+
 func ValidateUsername(value string) error {
 	if err := ValidateString(value, 3, 25); err != nil {
 		return err
@@ -30,3 +37,15 @@ func ValidateUsername(value string) error {
 func ValidatePassword(value string) error {
 	return ValidateString(value, 6, 50)
 }
+
+func ValidateGameID(value string) error {
+	return ws.ValidateGameID(value)
+}
+
+func ValidatePosition(value int32) error {
+	return ValidateNumber(value, 0, rules.MaxCells-1)
+}
+
+func ValidateColumn(value int32) error {
+	return ValidateNumber(value, 0, rules.MaxSize-1)
+}
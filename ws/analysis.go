@@ -0,0 +1,149 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"main/analysis"
+	"main/notation"
+	"main/rules"
+	"math/rand/v2"
+	"time"
+)
+
+// How long the engines think about a hint, and about each position of a game
+// being analysed
+const (
+	hintThinkingTime     = time.Second
+	analysisThinkingTime = 500 * time.Millisecond
+)
+
+// maxAnalyses bounds the game analyses running at once, as each keeps every
+// core busy for a while
+const maxAnalyses = 2
+
+// Hint is the move suggested to the player whose turn it is
+type Hint struct {
+	Move     int     `json:"move"`     // as stored in the move log
+	Notation string  `json:"notation"` // the move in game notation, e.g. "b2"
+	Score    float64 `json:"score"`    // expected result from -1 for a loss through 0 for a draw to 1 for a win
+	Exact    bool    `json:"exact"`    // true if the game was solved, so Score is certain
+}
+
+// RequestHint suggests the best move it can find to playerID, whose turn it
+// must be, in a game that offers hints
+func (m *Manager) RequestHint(gameID string, playerID string) (*Hint, error) {
+	game, err := m.GetGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case game.Players[playerID] == "":
+		return nil, &GameError{Code: ErrNotPlayer, Message: "You are not playing this game"}
+	case game.Rated:
+		return nil, &GameError{Code: ErrHintsNotAllowed, Message: "Hints are not allowed in rated games"}
+	case !game.Hints:
+		return nil, &GameError{Code: ErrHintsDisabled, Message: "Hints are disabled in this game"}
+	case !game.GameReady:
+		return nil, &GameError{Code: ErrGameNotReady, Message: "Game is not ready to start"}
+	case game.GameOver:
+		return nil, &GameError{Code: ErrGameNotReady, Message: "Game is already over"}
+	case game.Turn != playerID:
+		return nil, &GameError{Code: ErrNotPlayersTurn, Message: "Not your turn"}
+	}
+
+	// Think on the snapshot without holding the lock
+	evaluation := analysis.Evaluate(context.Background(), game.position, analysis.Options{TimeBudget: hintThinkingTime, Seed: rand.Uint64()})
+	best := evaluation.Best()
+	return &Hint{
+		Move:     best.Move,
+		Notation: notation.MoveName(best.Move, game.Settings),
+		Score:    best.Score,
+		Exact:    evaluation.Exact,
+	}, nil
+}
+
+// MoveAnalysis judges one move of a finished game against the best move of
+// its position. Scores are from the point of view of the player who moved.
+type MoveAnalysis struct {
+	MoveNumber   int     `json:"moveNumber"`
+	PlayerID     string  `json:"playerId"`
+	Position     int     `json:"position"`
+	Notation     string  `json:"notation"`
+	Label        string  `json:"label"` // "best", "inaccuracy" or "blunder"
+	Score        float64 `json:"score"`
+	BestMove     int     `json:"bestMove"`
+	BestNotation string  `json:"bestNotation"`
+	BestScore    float64 `json:"bestScore"`
+	Exact        bool    `json:"exact"` // true if the scores are certain rather than estimates
+}
+
+// GameAnalysis judges every move of a finished game
+type GameAnalysis struct {
+	GameID   string            `json:"gameId"`
+	Settings rules.Settings    `json:"settings"`
+	Players  map[string]string `json:"players"` // map[playerID]symbol (X or O)
+	Winner   string            `json:"winner"`
+	Moves    []MoveAnalysis    `json:"moves"`
+	// DecisiveMove is the move after which the result was no longer in doubt,
+	// or 0 if no move gave anything away
+	DecisiveMove int `json:"decisiveMove"`
+}
+
+// AnalyzeGame judges every move of a finished game played by playerID,
+// solving positions where the game tree is small enough and estimating the
+// rest. The analysis stops if ctx is done.
+func (m *Manager) AnalyzeGame(ctx context.Context, gameID string, playerID string) (*GameAnalysis, error) {
+	replay, err := m.LoadReplay(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := replay.Players[playerID]; !ok {
+		return nil, &GameError{Code: ErrNotPlayer, Message: "Only the players of a game can analyze it"}
+	}
+
+	select {
+	case m.analyses <- struct{}{}:
+		defer func() { <-m.analyses }()
+	default:
+		return nil, &GameError{Code: ErrAnalysisBusy, Message: "Too many games are being analyzed, try again later"}
+	}
+
+	moves := make([]int, len(replay.Steps))
+	for i, step := range replay.Steps {
+		moves[i] = step.Position
+	}
+
+	judged, err := analysis.Analyze(ctx, replay.Settings, moves, analysis.Options{TimeBudget: analysisThinkingTime})
+	if err != nil {
+		return nil, fmt.Errorf("cannot analyze game %s: %w", gameID, err)
+	}
+
+	result := &GameAnalysis{
+		GameID:   gameID,
+		Settings: replay.Settings,
+		Players:  replay.Players,
+		Winner:   replay.Winner,
+		Moves:    make([]MoveAnalysis, len(replay.Steps)),
+	}
+	for i, step := range replay.Steps {
+		ply := judged.Plies[i]
+		result.Moves[i] = MoveAnalysis{
+			MoveNumber:   step.MoveNumber,
+			PlayerID:     step.PlayerID,
+			Position:     step.Position,
+			Notation:     notation.MoveName(step.Position, replay.Settings),
+			Label:        string(ply.Label),
+			Score:        ply.Played.Score,
+			BestMove:     ply.Best.Move,
+			BestNotation: notation.MoveName(ply.Best.Move, replay.Settings),
+			BestScore:    ply.Best.Score,
+			Exact:        ply.Exact,
+		}
+	}
+	if judged.DecisivePly > 0 {
+		result.DecisiveMove = replay.Steps[judged.DecisivePly-1].MoveNumber
+	}
+
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package ws
+
+import (
+	"context"
+	"main/bot"
+	"main/rules"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CreateBotGame creates a game against a bot of the given level, which takes
+// the second seat straight away. Games against bots are unrated, so they may
+// offer hints.
+func (m *Manager) CreateBotGame(ctx context.Context, gameID string, playerID string, settings rules.Settings, level bot.Level, hints bool) error {
+	if !level.Valid() {
+		return &GameError{Code: ErrInvalidBotLevel, Message: "Unknown bot level " + string(level)}
+	}
+
+	if err := m.CreateGame(ctx, gameID, playerID, settings, GameOptions{Hints: hints}); err != nil {
+		return err
+	}
+	if err := m.JoinGame(ctx, gameID, bot.Username(level)); err != nil {
+		// Do not leave the player waiting for a bot that never comes
+		if abandonErr := m.AbandonGame(ctx, gameID); abandonErr != nil {
+			log.Error().
+				Err(abandonErr).
+				Str("game_id", gameID).
+				Msg("Failed to abandon bot game")
+		}
+		return err
+	}
+	return nil
+}
+
+// scheduleBot lets the bot whose turn it is in game move after the thinking
+// delay. The caller must hold the manager's lock.
+func (m *Manager) scheduleBot(gameID string, game *GameState) {
+	if !game.GameReady || game.GameOver {
+		return
+	}
+	level, ok := bot.LevelOf(game.Turn)
+	if !ok {
+		return
+	}
+
+	botID := game.Turn
+	time.AfterFunc(m.botDelay, func() {
+		m.playBot(gameID, botID, level)
+	})
+}
+
+// playBot makes a bot's move through the same path as a player's and
+// broadcasts the result
+func (m *Manager) playBot(gameID string, botID string, level bot.Level) {
+	game, err := m.GetGame(gameID)
+	if err != nil || game.GameOver || game.Turn != botID {
+		return
+	}
+
+	// Think without holding the lock; makeMove checks the turn again
+	start := time.Now()
+	rng := rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	move := bot.Choose(game.position, level, rng)
+
+	log.Debug().
+		Str("game_id", gameID).
+		Str("bot", botID).
+		Int("move", move).
+		Dur("thinking_time", time.Since(start)).
+		Msg("Bot chose move")
+
+	if err := m.makeMove(context.Background(), gameID, botID, move, moveKindOf(game.Settings)); err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("bot", botID).
+			Int("move", move).
+			Msg("Bot move failed")
+		return
+	}
+
+	m.BroadcastGameState(gameID)
+}
+
+// moveKindOf returns the kind of move a variant is played with
+func moveKindOf(settings rules.Settings) moveKind {
+	switch {
+	case settings.UsesColumns():
+		return columnMove
+	case settings.IsQuantum():
+		return quantumMove
+	}
+	return cellMove
+}
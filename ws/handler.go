@@ -1,10 +1,18 @@
 package ws
 
 import (
+	"context"
 	"fmt"
+	"main/bot"
+	"main/rules"
 	"main/token"
+	"math"
 	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/rs/zerolog/log"
 )
@@ -19,15 +27,20 @@ var upgrader = websocket.Upgrader{
 
 // Handler handles WebSocket connections
 type Handler struct {
-	manager    *Manager
-	tokenMaker token.Maker
+	manager        *Manager
+	matchmaker     *Matchmaker
+	tokenMaker     token.Maker
+	replayInterval time.Duration
 }
 
-// NewHandler creates a new WebSocket handler
-func NewHandler(manager *Manager, tokenMaker token.Maker) *Handler {
+// NewHandler creates a new WebSocket handler that replays finished games with
+// replayInterval between moves at normal speed
+func NewHandler(manager *Manager, matchmaker *Matchmaker, tokenMaker token.Maker, replayInterval time.Duration) *Handler {
 	return &Handler{
-		manager:    manager,
-		tokenMaker: tokenMaker,
+		manager:        manager,
+		matchmaker:     matchmaker,
+		tokenMaker:     tokenMaker,
+		replayInterval: replayInterval,
 	}
 }
 
@@ -87,11 +100,19 @@ func (h *Handler) HandleConnection(w http.ResponseWriter, r *http.Request) {
 
 // handleMessages processes incoming messages from the client
 func (h *Handler) handleMessages(client *Client) {
+	// ctx is done once the client disconnects, which also takes them out of
+	// matchmaking
+	ctx, cancel := context.WithCancel(context.Background())
+
 	defer func() {
+		cancel()
 		log.Info().
 			Str("client_id", client.ID).
-			Str("game_id", client.GameID).
+			Str("game_id", client.GameID()).
 			Msg("Client disconnecting, cleaning up")
+		if client.stopReplay != nil {
+			client.stopReplay()
+		}
 		h.manager.unregister <- client
 	}()
 
@@ -103,12 +124,12 @@ func (h *Handler) handleMessages(client *Client) {
 				log.Error().
 					Err(err).
 					Str("client_id", client.ID).
-					Str("game_id", client.GameID).
+					Str("game_id", client.GameID()).
 					Msg("Unexpected WebSocket closure")
 			} else {
 				log.Info().
 					Str("client_id", client.ID).
-					Str("game_id", client.GameID).
+					Str("game_id", client.GameID()).
 					Msg("WebSocket connection closed normally")
 			}
 			break
@@ -116,7 +137,7 @@ func (h *Handler) handleMessages(client *Client) {
 
 		log.Debug().
 			Str("client_id", client.ID).
-			Str("game_id", client.GameID).
+			Str("game_id", client.GameID()).
 			Str("message_type", message.Type).
 			Interface("message_data", message.Data).
 			Msg("Received WebSocket message")
@@ -126,15 +147,79 @@ func (h *Handler) handleMessages(client *Client) {
 		// Process message based on type
 		switch message.Type {
 		case "create_game":
+			// An empty game ID asks the server to generate one
 			gameID := message.GameID
+			if gameID == "" {
+				gameID = uuid.NewString()
+			}
 			log.Info().
 				Str("client_id", client.ID).
 				Str("game_id", gameID).
 				Msg("Creating new game")
 
-			h.manager.CreateGame(gameID, client.ID)
-			client.GameID = gameID
-			h.broadcastGameState(gameID)
+			if err := ValidateGameID(gameID); err != nil {
+				client.WriteJSON(&Message{
+					Type:   "error",
+					GameID: gameID,
+					Error:  &GameError{Code: ErrInvalidGameID, Message: err.Error()},
+				})
+				continue
+			}
+
+			settings, err := gameSettings(message.Data)
+			var level bot.Level
+			var options GameOptions
+			if err == nil {
+				level, err = botLevel(message.Data)
+			}
+			if err == nil {
+				options, err = gameOptions(message.Data, level != "")
+			}
+			if err != nil {
+				gameErr, ok := err.(*GameError)
+				if !ok {
+					gameErr = &GameError{Code: ErrInvalidSettings, Message: err.Error()}
+				}
+				response = &Message{
+					Type:   "error",
+					GameID: gameID,
+					Error:  gameErr,
+				}
+				client.WriteJSON(response)
+				continue
+			}
+
+			// An optional "bot" level seats a computer opponent
+			createGame := h.manager.CreateGame
+			if level != "" {
+				createGame = func(ctx context.Context, gameID string, playerID string, settings rules.Settings, options GameOptions) error {
+					return h.manager.CreateBotGame(ctx, gameID, playerID, settings, level, options.Hints)
+				}
+			}
+
+			if err := createGame(ctx, gameID, client.ID, settings, options); err != nil {
+				gameErr, ok := err.(*GameError)
+				if !ok {
+					gameErr = &GameError{Code: ErrInternal, Message: "Failed to create game"}
+				}
+				log.Warn().
+					Str("client_id", client.ID).
+					Str("game_id", gameID).
+					Str("error_code", gameErr.Code).
+					Str("error_message", gameErr.Message).
+					Msg("Failed to create game")
+
+				response = &Message{
+					Type:   "error",
+					GameID: gameID,
+					Error:  gameErr,
+				}
+				client.WriteJSON(response)
+				continue
+			}
+
+			client.setGameID(gameID)
+			h.manager.BroadcastGameState(gameID)
 
 		case "join_game":
 			gameID := message.GameID
@@ -143,7 +228,7 @@ func (h *Handler) handleMessages(client *Client) {
 				Str("game_id", gameID).
 				Msg("Attempting to join game")
 
-			if err := h.manager.JoinGame(gameID, client.ID); err != nil {
+			if err := h.manager.JoinGame(ctx, gameID, client.ID); err != nil {
 				if gameErr, ok := err.(*GameError); ok {
 					log.Warn().
 						Str("client_id", client.ID).
@@ -157,7 +242,7 @@ func (h *Handler) handleMessages(client *Client) {
 						GameID: gameID,
 						Error:  gameErr,
 					}
-					client.Conn.WriteJSON(response)
+					client.WriteJSON(response)
 					continue
 				}
 			}
@@ -167,24 +252,33 @@ func (h *Handler) handleMessages(client *Client) {
 				Str("game_id", gameID).
 				Msg("Successfully joined game")
 
-			client.GameID = gameID
-			h.broadcastGameState(gameID)
+			client.setGameID(gameID)
+			h.manager.BroadcastGameState(gameID)
 
 		case "make_move":
 			if data, ok := message.Data.(map[string]interface{}); ok {
-				if position, ok := data["position"].(float64); ok {
+				position, isPosition := data["position"].(float64)
+				column, isColumn := data["column"].(float64)
+				if isPosition || isColumn {
+					// Gravity games name the column a piece is dropped into
+					makeMove, move := h.manager.MakeMove, position
+					if isColumn {
+						makeMove, move = h.manager.DropPiece, column
+					}
+
 					log.Info().
 						Str("client_id", client.ID).
 						Str("game_id", message.GameID).
-						Float64("position", position).
+						Float64("move", move).
+						Bool("column", isColumn).
 						Msg("Attempting to make move")
 
-					if err := h.manager.MakeMove(message.GameID, client.ID, int(position)); err != nil {
+					if err := makeMove(ctx, message.GameID, client.ID, int(move)); err != nil {
 						if gameErr, ok := err.(*GameError); ok {
 							log.Warn().
 								Str("client_id", client.ID).
 								Str("game_id", message.GameID).
-								Float64("position", position).
+								Float64("move", move).
 								Str("error_code", gameErr.Code).
 								Str("error_message", gameErr.Message).
 								Msg("Invalid move attempt")
@@ -194,7 +288,7 @@ func (h *Handler) handleMessages(client *Client) {
 								GameID: message.GameID,
 								Error:  gameErr,
 							}
-							client.Conn.WriteJSON(response)
+							client.WriteJSON(response)
 							continue
 						}
 					}
@@ -202,10 +296,11 @@ func (h *Handler) handleMessages(client *Client) {
 					log.Info().
 						Str("client_id", client.ID).
 						Str("game_id", message.GameID).
-						Float64("position", position).
+						Float64("move", move).
 						Msg("Move successful")
 
-					h.broadcastGameState(message.GameID)
+					client.setGameID(message.GameID)
+					h.manager.BroadcastGameState(message.GameID)
 				} else {
 					log.Warn().
 						Str("client_id", client.ID).
@@ -218,10 +313,10 @@ func (h *Handler) handleMessages(client *Client) {
 						GameID: message.GameID,
 						Error: &GameError{
 							Code:    "INVALID_POSITION_FORMAT",
-							Message: "Position must be a number",
+							Message: "Position or column must be a number",
 						},
 					}
-					client.Conn.WriteJSON(response)
+					client.WriteJSON(response)
 				}
 			} else {
 				log.Warn().
@@ -238,8 +333,194 @@ func (h *Handler) handleMessages(client *Client) {
 						Message: "Invalid move data format",
 					},
 				}
-				client.Conn.WriteJSON(response)
+				client.WriteJSON(response)
+			}
+		case "quantum_move":
+			first, second, err := spookyCells(message.Data)
+			if err != nil {
+				client.WriteJSON(&Message{
+					Type:   "error",
+					GameID: message.GameID,
+					Error: &GameError{
+						Code:    "INVALID_MOVE_FORMAT",
+						Message: err.Error(),
+					},
+				})
+				continue
+			}
+
+			log.Info().
+				Str("client_id", client.ID).
+				Str("game_id", message.GameID).
+				Int("first", first).
+				Int("second", second).
+				Msg("Attempting to place spooky marks")
+
+			h.submitMove(client, message.GameID, func() error {
+				return h.manager.PlaceSpookyMarks(ctx, message.GameID, client.ID, first, second)
+			})
+
+		case "collapse":
+			data, _ := message.Data.(map[string]interface{})
+			position, ok := data["position"].(float64)
+			if !ok {
+				client.WriteJSON(&Message{
+					Type:   "error",
+					GameID: message.GameID,
+					Error: &GameError{
+						Code:    "INVALID_POSITION_FORMAT",
+						Message: "Position must be a number",
+					},
+				})
+				continue
+			}
+
+			log.Info().
+				Str("client_id", client.ID).
+				Str("game_id", message.GameID).
+				Float64("position", position).
+				Msg("Attempting to collapse cycle")
+
+			h.submitMove(client, message.GameID, func() error {
+				return h.manager.CollapseCycle(ctx, message.GameID, client.ID, int(position))
+			})
+
+		case "resign":
+			h.submitAction(client, message.GameID, message.Type, func() error {
+				return h.manager.Resign(ctx, message.GameID, client.ID)
+			})
+
+		case "offer_draw":
+			h.submitAction(client, message.GameID, message.Type, func() error {
+				return h.manager.OfferDraw(ctx, message.GameID, client.ID)
+			})
+
+		case "accept_draw":
+			h.submitAction(client, message.GameID, message.Type, func() error {
+				return h.manager.AcceptDraw(ctx, message.GameID, client.ID)
+			})
+
+		case "decline_draw":
+			h.submitAction(client, message.GameID, message.Type, func() error {
+				return h.manager.DeclineDraw(ctx, message.GameID, client.ID)
+			})
+
+		case "request_hint":
+			log.Info().
+				Str("client_id", client.ID).
+				Str("game_id", message.GameID).
+				Msg("Requesting hint")
+
+			// Thinking takes a while, so keep reading messages meanwhile, but
+			// refuse further hints until this one is sent
+			if !client.hinting.CompareAndSwap(false, true) {
+				response = &Message{
+					Type:   "error",
+					GameID: message.GameID,
+					Error:  &GameError{Code: ErrHintPending, Message: "A hint is already being worked out"},
+				}
+				client.WriteJSON(response)
+				continue
+			}
+			go h.sendHint(client, message.GameID)
+
+		case "find_match":
+			log.Info().
+				Str("client_id", client.ID).
+				Msg("Searching for a match")
+
+			ticket, err := h.matchmaker.FindMatch(ctx, client.ID)
+			if err != nil {
+				if gameErr, ok := err.(*GameError); ok {
+					response = &Message{
+						Type:  "error",
+						Error: gameErr,
+					}
+					client.WriteJSON(response)
+					continue
+				}
+			}
+
+			client.WriteJSON(&Message{Type: "match_queued"})
+			go h.awaitMatch(ctx, client, ticket)
+
+		case "cancel_match":
+			log.Info().
+				Str("client_id", client.ID).
+				Msg("Cancelling match search")
+
+			// The pending awaitMatch goroutine reports the cancellation
+			if err := h.matchmaker.CancelMatch(client.ID); err != nil {
+				if gameErr, ok := err.(*GameError); ok {
+					response = &Message{
+						Type:  "error",
+						Error: gameErr,
+					}
+					client.WriteJSON(response)
+				}
+			}
+
+		case "replay":
+			speed := 1.0
+			if data, ok := message.Data.(map[string]interface{}); ok {
+				if value, ok := data["speed"].(float64); ok {
+					speed = value
+				}
+			}
+
+			if speed < minReplaySpeed || speed > maxReplaySpeed {
+				client.WriteJSON(&Message{
+					Type:   "error",
+					GameID: message.GameID,
+					Error: &GameError{
+						Code:    "INVALID_REPLAY_SPEED",
+						Message: fmt.Sprintf("Speed must be between %g and %g", float64(minReplaySpeed), float64(maxReplaySpeed)),
+					},
+				})
+				continue
+			}
+
+			log.Info().
+				Str("client_id", client.ID).
+				Str("game_id", message.GameID).
+				Float64("speed", speed).
+				Msg("Starting game replay")
+
+			replay, err := h.manager.LoadReplay(ctx, message.GameID)
+			if err != nil {
+				gameErr, ok := err.(*GameError)
+				if !ok {
+					log.Error().
+						Err(err).
+						Str("client_id", client.ID).
+						Str("game_id", message.GameID).
+						Msg("Failed to load replay")
+					gameErr = &GameError{Code: ErrInternal, Message: "Failed to load replay"}
+				}
+
+				response = &Message{
+					Type:   "error",
+					GameID: message.GameID,
+					Error:  gameErr,
+				}
+				client.WriteJSON(response)
+				continue
+			}
+
+			// A client watches one replay at a time
+			if client.stopReplay != nil {
+				client.stopReplay()
 			}
+			replayCtx, stopReplay := context.WithCancel(ctx)
+			client.stopReplay = stopReplay
+			go streamReplay(replayCtx, client, replay, time.Duration(float64(h.replayInterval)/speed))
+
+		case "stop_replay":
+			if client.stopReplay != nil {
+				client.stopReplay()
+				client.stopReplay = nil
+			}
+
 		default:
 			log.Warn().
 				Str("client_id", client.ID).
@@ -254,32 +535,286 @@ func (h *Handler) handleMessages(client *Client) {
 					Message: "Unknown message type",
 				},
 			}
-			client.Conn.WriteJSON(response)
+			client.WriteJSON(response)
+		}
+	}
+}
+
+var isValidGameID = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`).MatchString
+
+// ValidateGameID checks that a game ID is 1 to 64 letters, numbers,
+// underscores or dashes. It lives here so the handler can use it, as utils
+// depends on ws for its converters.
+func ValidateGameID(gameID string) error {
+	if n := len(gameID); n < 1 || n > 64 {
+		return fmt.Errorf("length must be between 1 and 64")
+	}
+	if !isValidGameID(gameID) {
+		return fmt.Errorf("game id can only contain letters, numbers, underscores and dashes")
+	}
+	return nil
+}
+
+// gameSettings reads the optional variant and board settings of a
+// create_game message; missing fields keep the defaults of the variant, which
+// is standard 3x3 unless given
+func gameSettings(data interface{}) (rules.Settings, error) {
+	settings := rules.Standard
+	if data == nil {
+		return settings, nil
+	}
+
+	fields, ok := data.(map[string]interface{})
+	if !ok {
+		return settings, fmt.Errorf("invalid settings format")
+	}
+
+	if value, present := fields["variant"]; present {
+		variant, ok := value.(string)
+		if !ok {
+			return settings, fmt.Errorf("variant must be a string")
+		}
+		settings = rules.DefaultSettings(variant)
+		settings.Variant = variant
+	}
+
+	targets := map[string]*int{
+		"width":     &settings.Width,
+		"height":    &settings.Height,
+		"winLength": &settings.WinLength,
+		"depth":     &settings.Depth,
+	}
+	for name, target := range targets {
+		value, present := fields[name]
+		if !present {
+			continue
+		}
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return settings, fmt.Errorf("%s must be an integer", name)
+		}
+		*target = int(number)
+	}
+
+	return settings, nil
+}
+
+// botLevel reads the optional "bot" level of a create_game message, which is
+// empty for a game against another player
+func botLevel(data interface{}) (bot.Level, error) {
+	fields, _ := data.(map[string]interface{})
+	value, present := fields["bot"]
+	if !present {
+		return "", nil
+	}
+
+	name, ok := value.(string)
+	if level := bot.Level(name); ok && level.Valid() {
+		return level, nil
+	}
+	return "", &GameError{Code: ErrInvalidBotLevel, Message: fmt.Sprintf("Bot level must be one of %v", bot.Levels)}
+}
+
+// gameOptions reads the optional "rated" and "hints" flags of a create_game
+// message. Games are rated unless played against a bot, which cannot be, and
+// unrated games offer hints unless they are turned off.
+func gameOptions(data interface{}, againstBot bool) (GameOptions, error) {
+	fields, _ := data.(map[string]interface{})
+	options := GameOptions{Rated: !againstBot}
+
+	if value, present := fields["rated"]; present {
+		rated, ok := value.(bool)
+		if !ok {
+			return options, fmt.Errorf("rated must be a boolean")
 		}
+		if rated && againstBot {
+			return options, fmt.Errorf("games against a bot cannot be rated")
+		}
+		options.Rated = rated
+	}
+
+	options.Hints = !options.Rated
+	if value, present := fields["hints"]; present {
+		hints, ok := value.(bool)
+		if !ok {
+			return options, fmt.Errorf("hints must be a boolean")
+		}
+		options.Hints = hints
 	}
+
+	return options, nil
 }
 
-// broadcastGameState sends the current game state to all players in the game
-func (h *Handler) broadcastGameState(gameID string) {
-	h.manager.mutex.RLock()
-	game, exists := h.manager.games[gameID]
-	h.manager.mutex.RUnlock()
+// spookyCells reads the two cells of a quantum move from {"cells": [a, b]}
+func spookyCells(data interface{}) (int, int, error) {
+	fields, _ := data.(map[string]interface{})
+	cells, ok := fields["cells"].([]interface{})
+	if !ok || len(cells) != 2 {
+		return 0, 0, fmt.Errorf("cells must list two positions")
+	}
+
+	var positions [2]int
+	for i, cell := range cells {
+		number, ok := cell.(float64)
+		if !ok || number != math.Trunc(number) {
+			return 0, 0, fmt.Errorf("cells must be integers")
+		}
+		positions[i] = int(number)
+	}
+	return positions[0], positions[1], nil
+}
 
-	if !exists {
+// submitMove plays a quantum step for the client and broadcasts the new
+// state, or tells the client why it was rejected
+func (h *Handler) submitMove(client *Client, gameID string, play func() error) {
+	if err := play(); err != nil {
+		gameErr, ok := err.(*GameError)
+		if !ok {
+			gameErr = &GameError{Code: ErrInternal, Message: "Failed to make move"}
+		}
 		log.Warn().
+			Str("client_id", client.ID).
 			Str("game_id", gameID).
-			Msg("Attempted to broadcast state for non-existent game")
+			Str("error_code", gameErr.Code).
+			Str("error_message", gameErr.Message).
+			Msg("Invalid move attempt")
+
+		client.WriteJSON(&Message{
+			Type:   "error",
+			GameID: gameID,
+			Error:  gameErr,
+		})
 		return
 	}
 
-	log.Debug().
+	log.Info().
+		Str("client_id", client.ID).
 		Str("game_id", gameID).
-		Interface("game_state", game).
-		Msg("Broadcasting game state")
+		Msg("Move successful")
 
-	h.manager.broadcast <- &Message{
-		Type:   "game_state",
+	client.setGameID(gameID)
+	h.manager.BroadcastGameState(gameID)
+}
+
+// submitAction performs a resignation or draw action for the client and
+// broadcasts the new state to both players, or tells the client why it was
+// rejected
+func (h *Handler) submitAction(client *Client, gameID string, action string, perform func() error) {
+	log.Info().
+		Str("client_id", client.ID).
+		Str("game_id", gameID).
+		Str("action", action).
+		Msg("Attempting game action")
+
+	if err := perform(); err != nil {
+		gameErr, ok := err.(*GameError)
+		if !ok {
+			gameErr = &GameError{Code: ErrInternal, Message: "Failed to " + strings.ReplaceAll(action, "_", " ")}
+		}
+		log.Warn().
+			Str("client_id", client.ID).
+			Str("game_id", gameID).
+			Str("action", action).
+			Str("error_code", gameErr.Code).
+			Str("error_message", gameErr.Message).
+			Msg("Invalid game action")
+
+		client.WriteJSON(&Message{
+			Type:   "error",
+			GameID: gameID,
+			Error:  gameErr,
+		})
+		return
+	}
+
+	client.setGameID(gameID)
+	h.manager.BroadcastGameState(gameID)
+}
+
+// sendHint tells the client which move the engines suggest, or why it
+// cannot have a hint
+func (h *Handler) sendHint(client *Client, gameID string) {
+	defer client.hinting.Store(false)
+
+	hint, err := h.manager.RequestHint(gameID, client.ID)
+	if err != nil {
+		gameErr, ok := err.(*GameError)
+		if !ok {
+			gameErr = &GameError{Code: ErrInternal, Message: "Failed to find a hint"}
+		}
+		log.Warn().
+			Str("client_id", client.ID).
+			Str("game_id", gameID).
+			Str("error_code", gameErr.Code).
+			Str("error_message", gameErr.Message).
+			Msg("Hint refused")
+
+		client.WriteJSON(&Message{
+			Type:   "error",
+			GameID: gameID,
+			Error:  gameErr,
+		})
+		return
+	}
+
+	client.WriteJSON(&Message{
+		Type:   "hint",
 		GameID: gameID,
-		Data:   game,
+		Data:   hint,
+	})
+}
+
+// awaitMatch waits for a client's matchmaking ticket and reports the outcome.
+// If the client disconnects first, it leaves the queue, or abandons the game
+// if a match was made meanwhile.
+func (h *Handler) awaitMatch(ctx context.Context, client *Client, ticket *Ticket) {
+	var result MatchResult
+	select {
+	case <-ctx.Done():
+		h.matchmaker.LeaveMatch(context.WithoutCancel(ctx), ticket)
+		return
+	case result = <-ticket.Result():
 	}
+	if result.Err != nil {
+		gameErr, ok := result.Err.(*GameError)
+		if !ok {
+			gameErr = &GameError{Code: ErrInternal, Message: "Matchmaking failed"}
+		}
+
+		if gameErr.Code == ErrMatchCancelled {
+			client.WriteJSON(&Message{Type: "match_cancelled"})
+			return
+		}
+
+		client.WriteJSON(&Message{
+			Type:  "error",
+			Error: gameErr,
+		})
+		return
+	}
+
+	game, err := h.manager.GetGame(result.GameID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("client_id", client.ID).
+			Str("game_id", result.GameID).
+			Msg("Matched game disappeared")
+		return
+	}
+
+	client.setGameID(result.GameID)
+	client.WriteJSON(&Message{
+		Type:   "match_found",
+		GameID: result.GameID,
+		Data: map[string]string{
+			"opponent": result.Opponent,
+			"symbol":   game.Players[client.ID],
+		},
+	})
+	client.WriteJSON(&Message{
+		Type:   "game_state",
+		GameID: result.GameID,
+		Data:   game,
+	})
 }
@@ -1,30 +1,93 @@
 package ws
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	db "main/db/sqlc"
+	"main/rules"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
 )
 
 // GameState represents the current state of a Tic-Tac-Toe game
 type GameState struct {
-	Board     [9]string         `json:"board"`
+	Settings  rules.Settings    `json:"settings"`
+	Board     rules.Board       `json:"board"`
 	Players   map[string]string `json:"players"` // map[playerID]symbol (X or O)
 	Turn      string            `json:"turn"`    // playerID whose turn it is
 	Winner    string            `json:"winner"`  // playerID of winner, empty if no winner
 	GameOver  bool              `json:"gameOver"`
 	GameReady bool              `json:"gameReady"`
+	Moves     int               `json:"moves"`             // moves played so far, counting quantum collapses
+	Rated     bool              `json:"rated"`             // false for casual games and games against a bot
+	Hints     bool              `json:"hints"`             // whether players may ask for hints, never in rated games
+	Details   interface{}       `json:"details,omitempty"` // variant specific state, e.g. the active ultimate sub-board
+
+	Reason     string `json:"reason,omitempty"`     // how a finished game ended: "played_out", "resignation", "draw_agreed" or "abandoned"
+	ResignedBy string `json:"resignedBy,omitempty"` // playerID who resigned
+	DrawOffer  string `json:"drawOffer,omitempty"`  // playerID whose draw offer awaits an answer
+
+	position rules.Position
+}
+
+// clone returns a deep copy of the game state
+func (g *GameState) clone() *GameState {
+	copied := *g
+	copied.Board = append(rules.Board(nil), g.Board...)
+	copied.Players = make(map[string]string, len(g.Players))
+	for playerID, symbol := range g.Players {
+		copied.Players[playerID] = symbol
+	}
+	return &copied
 }
 
 // Client represents a connected player
 type Client struct {
-	ID      string
-	Conn    *websocket.Conn
-	GameID  string
-	Symbol  string
-	Manager *Manager
+	ID         string
+	Conn       *websocket.Conn
+	Symbol     string
+	Manager    *Manager
+	writeMutex sync.Mutex
+
+	// gameID is the game whose broadcasts the client receives. Matchmaking
+	// sets it from its own goroutine, so it is guarded by gameMutex.
+	gameID    string
+	gameMutex sync.Mutex
+
+	// stopReplay cancels the replay being streamed to the client, if any
+	stopReplay context.CancelFunc
+	// hinting is set while a hint is being worked out for the client, who
+	// may only wait for one at a time
+	hinting atomic.Bool
+}
+
+// GameID returns the game whose broadcasts the client receives
+func (c *Client) GameID() string {
+	c.gameMutex.Lock()
+	defer c.gameMutex.Unlock()
+	return c.gameID
+}
+
+// setGameID makes the client receive the broadcasts of gameID
+func (c *Client) setGameID(gameID string) {
+	c.gameMutex.Lock()
+	defer c.gameMutex.Unlock()
+	c.gameID = gameID
+}
+
+// WriteJSON sends a message to the client, serializing writers since a
+// WebSocket connection supports only one concurrent writer
+func (c *Client) WriteJSON(v interface{}) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return c.Conn.WriteJSON(v)
 }
 
 // GameError represents a game-related error
@@ -54,26 +117,67 @@ const (
 	ErrNotPlayersTurn   = "NOT_PLAYERS_TURN"
 	ErrGameNotReady     = "GAME_NOT_READY"
 	ErrPositionOccupied = "POSITION_OCCUPIED"
+	ErrGameExists       = "GAME_EXISTS"
+	ErrInternal         = "INTERNAL_ERROR"
+	ErrAlreadyQueued    = "ALREADY_QUEUED"
+	ErrNotQueued        = "NOT_QUEUED"
+	ErrMatchTimeout     = "MATCH_TIMEOUT"
+	ErrMatchCancelled   = "MATCH_CANCELLED"
+	ErrGameNotFinished  = "GAME_NOT_FINISHED"
+	ErrInvalidNotation  = "INVALID_NOTATION"
+	ErrUserNotFound     = "USER_NOT_FOUND"
+	ErrInvalidSettings  = "INVALID_SETTINGS"
+	ErrWrongSubBoard    = "WRONG_SUB_BOARD"
+	ErrSubBoardClosed   = "SUB_BOARD_CLOSED"
+	ErrInvalidColumn    = "INVALID_COLUMN"
+	ErrColumnFull       = "COLUMN_FULL"
+	ErrColumnRequired   = "COLUMN_REQUIRED"
+	ErrPositionRequired = "POSITION_REQUIRED"
+	ErrQuantumRequired  = "QUANTUM_MOVE_REQUIRED"
+	ErrSpookyPair       = "SPOOKY_PAIR_REQUIRED"
+	ErrCollapseRequired = "COLLAPSE_REQUIRED"
+	ErrNoCollapse       = "NO_COLLAPSE_PENDING"
+	ErrInvalidCollapse  = "INVALID_COLLAPSE"
+	ErrInvalidBotLevel  = "INVALID_BOT_LEVEL"
+	ErrHintsNotAllowed  = "HINTS_NOT_ALLOWED"
+	ErrHintsDisabled    = "HINTS_DISABLED"
+	ErrNotPlayer        = "NOT_A_PLAYER"
+	ErrDrawOffered      = "DRAW_ALREADY_OFFERED"
+	ErrNoDrawOffer      = "NO_DRAW_OFFER"
+	ErrInvalidGameID    = "INVALID_GAME_ID"
+	ErrAnalysisBusy     = "ANALYSIS_BUSY"
+	ErrHintPending      = "HINT_PENDING"
 )
 
 // Manager handles WebSocket connections and game states
 type Manager struct {
+	store      db.Store
 	games      map[string]*GameState
 	clients    map[string]*Client
 	register   chan *Client
 	unregister chan *Client
 	broadcast  chan *Message
 	mutex      sync.RWMutex
+	watchers   map[string]map[chan *Message]struct{}
+	watchMutex sync.Mutex
+	botDelay   time.Duration
+	// analyses holds a token for every game analysis running
+	analyses chan struct{}
 }
 
-// NewManager creates a new WebSocket manager
-func NewManager() *Manager {
+// NewManager creates a new WebSocket manager whose bots think for botDelay
+// before each move
+func NewManager(store db.Store, botDelay time.Duration) *Manager {
 	return &Manager{
+		store:      store,
+		botDelay:   botDelay,
 		games:      make(map[string]*GameState),
 		clients:    make(map[string]*Client),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		broadcast:  make(chan *Message),
+		watchers:   make(map[string]map[chan *Message]struct{}),
+		analyses:   make(chan struct{}, maxAnalyses),
 	}
 }
 
@@ -95,7 +199,7 @@ func (m *Manager) Start() {
 			if _, ok := m.clients[client.ID]; ok {
 				log.Info().
 					Str("client_id", client.ID).
-					Str("game_id", client.GameID).
+					Str("game_id", client.GameID()).
 					Int("remaining_clients", len(m.clients)-1).
 					Msg("Unregistering client")
 
@@ -107,6 +211,7 @@ func (m *Manager) Start() {
 
 		case message := <-m.broadcast:
 			m.broadcastToGame(message)
+			m.notifyWatchers(message)
 		}
 	}
 }
@@ -117,8 +222,8 @@ func (m *Manager) broadcastToGame(message *Message) {
 	defer m.mutex.RUnlock()
 
 	for _, client := range m.clients {
-		if client.GameID == message.GameID {
-			err := client.Conn.WriteJSON(message)
+		if client.GameID() == message.GameID {
+			err := client.WriteJSON(message)
 			if err != nil {
 				log.Error().Err(err).Str("clientID", client.ID).Msg("Error broadcasting message")
 				// Send error message to client before potential disconnect
@@ -126,7 +231,7 @@ func (m *Manager) broadcastToGame(message *Message) {
 					Type:  "error",
 					Error: &GameError{Code: "BROADCAST_ERROR", Message: "Failed to send message"},
 				}
-				client.Conn.WriteJSON(errorMsg)
+				client.WriteJSON(errorMsg)
 
 				// Only disconnect if it's a fatal error
 				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
@@ -138,35 +243,202 @@ func (m *Manager) broadcastToGame(message *Message) {
 	}
 }
 
-// CreateGame initializes a new game
-func (m *Manager) CreateGame(gameID string, playerID string) {
+// RestoreGames reloads every unfinished game from the store so that players
+// can reconnect after a server restart
+func (m *Manager) RestoreGames(ctx context.Context) error {
+	games, err := m.store.ListActiveGames(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot list active games: %w", err)
+	}
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	restored := 0
+	for _, row := range games {
+		game, err := m.loadGame(ctx, row)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("game_id", row.Code).
+				Msg("Skipping restore of game")
+			continue
+		}
+
+		m.games[row.Code] = game
+		m.scheduleBot(row.Code, game)
+		restored++
+	}
+
+	log.Info().
+		Int("restored_games", restored).
+		Msg("Restored active games from store")
+
+	return nil
+}
+
+// RebuildGame reconstructs the state of any stored game by replaying its move log
+func (m *Manager) RebuildGame(ctx context.Context, gameID string) (*GameState, error) {
+	game, err := m.store.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get game %s: %w", gameID, err)
+	}
+
+	return m.loadGame(ctx, game)
+}
+
+// loadGame replays a stored game's moves on top of its participants
+func (m *Manager) loadGame(ctx context.Context, game db.Game) (*GameState, error) {
+	players, err := m.store.ListGameParticipantUsernames(ctx, pgtype.Int8{Int64: game.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list participants of game %s: %w", game.Code, err)
+	}
+
+	rows, err := m.store.ListGameMoves(ctx, game.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list moves of game %s: %w", game.Code, err)
+	}
+
+	moves := make([]Move, len(rows))
+	for i, row := range rows {
+		moves[i] = Move{PlayerID: row.Username, Position: int(row.Position)}
+	}
+
+	state, err := ReplayGame(game.Code, settingsOf(game), players, moves)
+	if err != nil {
+		return nil, err
+	}
+	state.Rated = game.Rated
+	state.Hints = game.Hints
+
+	if err := m.restoreResult(ctx, game, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// GetGame returns a snapshot of the current state of a live game
+func (m *Manager) GetGame(gameID string) (*GameState, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	game, exists := m.games[gameID]
+	if !exists {
+		return nil, &GameError{Code: ErrGameNotFound, Message: "Game not found"}
+	}
+
+	return game.clone(), nil
+}
+
+// BroadcastGameState sends the current game state to all players in the game
+func (m *Manager) BroadcastGameState(gameID string) {
+	game, err := m.GetGame(gameID)
+	if err != nil {
+		log.Warn().
+			Str("game_id", gameID).
+			Msg("Attempted to broadcast state for non-existent game")
+		return
+	}
+
+	log.Debug().
+		Str("game_id", gameID).
+		Interface("game_state", game).
+		Msg("Broadcasting game state")
+
+	m.broadcast <- &Message{
+		Type:   "game_state",
+		GameID: gameID,
+		Data:   game,
+	}
+}
+
+// settingsOf returns the board settings a game was created with
+func settingsOf(game db.Game) rules.Settings {
+	return rules.Settings{
+		Variant:   game.Variant,
+		Width:     int(game.Width),
+		Height:    int(game.Height),
+		WinLength: int(game.WinLength),
+		Depth:     int(game.Depth),
+	}
+}
+
+// GameOptions are the choices a game is created with besides its board
+type GameOptions struct {
+	// Rated games update the ratings of both players
+	Rated bool
+	// Hints lets players ask for the best move, which rated games forbid
+	Hints bool
+}
+
+// CreateGame initializes a new game with the given board settings and persists it
+func (m *Manager) CreateGame(ctx context.Context, gameID string, playerID string, settings rules.Settings, options GameOptions) error {
+	if err := settings.Validate(); err != nil {
+		return &GameError{Code: ErrInvalidSettings, Message: err.Error()}
+	}
+	if options.Rated && options.Hints {
+		return &GameError{Code: ErrHintsNotAllowed, Message: "Rated games cannot offer hints"}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.games[gameID]; exists {
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Attempted to create game with existing ID")
+		return &GameError{Code: ErrGameExists, Message: "Game already exists"}
+	}
+
 	log.Info().
 		Str("game_id", gameID).
 		Str("player_id", playerID).
 		Int("total_games", len(m.games)+1).
 		Msg("Creating new game")
 
-	m.games[gameID] = &GameState{
-		Board:     [9]string{},
-		Players:   make(map[string]string),
-		Turn:      playerID,
-		GameOver:  false,
-		GameReady: false,
+	game := newGameState(settings, []string{playerID})
+	game.Rated = options.Rated
+	game.Hints = options.Hints
+
+	_, err := m.store.CreateGameTx(ctx, db.CreateGameTxParams{
+		Code:         gameID,
+		HostUsername: playerID,
+		CurrentState: encodeBoard(game.Board),
+		Variant:      settings.Variant,
+		Width:        int32(settings.Width),
+		Height:       int32(settings.Height),
+		WinLength:    int32(settings.WinLength),
+		Depth:        int32(settings.Depth),
+		Rated:        options.Rated,
+		Hints:        options.Hints,
+	})
+	if err != nil {
+		// Games finished before a restart are not restored, but their IDs stay taken
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return &GameError{Code: ErrGameExists, Message: "Game already exists"}
+		}
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Failed to persist new game")
+		return &GameError{Code: ErrInternal, Message: "Failed to save game"}
 	}
-	m.games[gameID].Players[playerID] = "X" // First player is X
+
+	m.games[gameID] = game
 
 	log.Debug().
 		Str("game_id", gameID).
 		Str("player_id", playerID).
-		Interface("game_state", m.games[gameID]).
+		Interface("game_state", game).
 		Msg("Game created successfully")
+
+	return nil
 }
 
 // JoinGame adds a player to an existing game
-func (m *Manager) JoinGame(gameID string, playerID string) error {
+func (m *Manager) JoinGame(ctx context.Context, gameID string, playerID string) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -179,6 +451,14 @@ func (m *Manager) JoinGame(gameID string, playerID string) error {
 		return &GameError{Code: ErrGameNotFound, Message: "Game not found"}
 	}
 
+	if _, joined := game.Players[playerID]; joined {
+		log.Info().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Player rejoined game")
+		return nil
+	}
+
 	if len(game.Players) >= 2 {
 		log.Warn().
 			Str("game_id", gameID).
@@ -188,7 +468,35 @@ func (m *Manager) JoinGame(gameID string, playerID string) error {
 		return &GameError{Code: ErrGameFull, Message: "Game is already full"}
 	}
 
-	game.Players[playerID] = "O" // Second player is O
+	if game.GameOver {
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Attempted to join finished game")
+		return &GameError{Code: ErrGameNotReady, Message: "Game is already over"}
+	}
+
+	_, err := m.store.JoinGameTx(ctx, db.JoinGameTxParams{
+		Code:     gameID,
+		Username: playerID,
+	})
+	if errors.Is(err, db.ErrGameNotWaiting) {
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Attempted to join game that is no longer waiting")
+		return &GameError{Code: ErrGameFull, Message: "Game is no longer waiting for a player"}
+	}
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Msg("Failed to persist game join")
+		return &GameError{Code: ErrInternal, Message: "Failed to save game"}
+	}
+
+	game.Players[playerID] = SeatSymbol(1) // Second player is O
 	game.GameReady = true
 
 	log.Info().
@@ -200,8 +508,61 @@ func (m *Manager) JoinGame(gameID string, playerID string) error {
 	return nil
 }
 
-// MakeMove handles a player's move
-func (m *Manager) MakeMove(gameID string, playerID string, position int) error {
+// MakeMove handles a player's move on a board position
+func (m *Manager) MakeMove(ctx context.Context, gameID string, playerID string, position int) error {
+	return m.makeMove(ctx, gameID, playerID, position, cellMove)
+}
+
+// DropPiece handles a player's move in a gravity game, where the piece falls
+// to the lowest empty cell of column
+func (m *Manager) DropPiece(ctx context.Context, gameID string, playerID string, column int) error {
+	return m.makeMove(ctx, gameID, playerID, column, columnMove)
+}
+
+// PlaceSpookyMarks handles the first step of a turn in a quantum game: the
+// player's symbol goes into superposition on two cells, or classically on the
+// last free cell when both cells are the same
+func (m *Manager) PlaceSpookyMarks(ctx context.Context, gameID string, playerID string, first int, second int) error {
+	return m.makeMove(ctx, gameID, playerID, rules.QuantumMove(first, second), quantumMove)
+}
+
+// CollapseCycle handles the choice owed after the opponent closed a cycle of
+// spooky marks in a quantum game: the closing mark collapses into position,
+// which must be one of its two cells, and the rest of the cycle follows
+func (m *Manager) CollapseCycle(ctx context.Context, gameID string, playerID string, position int) error {
+	return m.makeMove(ctx, gameID, playerID, rules.CollapseMove(position), collapseMove)
+}
+
+// moveKind tells how a move names its target
+type moveKind int
+
+const (
+	cellMove moveKind = iota
+	columnMove
+	quantumMove
+	collapseMove
+)
+
+// checkMoveKind makes sure a move is of the kind the variant expects
+func checkMoveKind(settings rules.Settings, kind moveKind) error {
+	switch {
+	case settings.UsesColumns():
+		if kind != columnMove {
+			return &GameError{Code: ErrColumnRequired, Message: "Moves in this game name a column"}
+		}
+	case settings.IsQuantum():
+		if kind != quantumMove && kind != collapseMove {
+			return &GameError{Code: ErrQuantumRequired, Message: "Moves in this game place spooky marks or collapse a cycle"}
+		}
+	case kind != cellMove:
+		return &GameError{Code: ErrPositionRequired, Message: "Moves in this game name a position"}
+	}
+	return nil
+}
+
+// makeMove plays a move of the given kind: a position, a column in variants
+// where pieces drop, or an encoded quantum move
+func (m *Manager) makeMove(ctx context.Context, gameID string, playerID string, position int, kind moveKind) error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -215,6 +576,102 @@ func (m *Manager) MakeMove(gameID string, playerID string, position int) error {
 		return &GameError{Code: ErrGameNotFound, Message: "Game not found"}
 	}
 
+	if err := checkMoveKind(game.Settings, kind); err != nil {
+		return err
+	}
+
+	// Apply the move to a copy so the live state only changes once it is stored
+	next := *game
+	if err := applyMove(gameID, &next, playerID, position); err != nil {
+		return err
+	}
+
+	arg := db.MakeMoveTxParams{
+		Code:         gameID,
+		Username:     playerID,
+		Position:     int32(position),
+		Symbol:       movedSymbol(game.position, next.position),
+		Status:       db.GameStatusInProgress,
+		CurrentState: encodeBoard(next.Board),
+	}
+	if next.GameOver {
+		arg.Status = db.GameStatusCompleted
+		arg.WinnerUsername = next.Winner
+	} else {
+		arg.NextTurnUsername = next.Turn
+	}
+
+	if _, err := m.store.MakeMoveTx(ctx, arg); err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("position", position).
+			Msg("Failed to persist move")
+		return &GameError{Code: ErrInternal, Message: "Failed to save game"}
+	}
+
+	*game = next
+	m.scheduleBot(gameID, game)
+	return nil
+}
+
+// Move is a single entry of a game's move log
+type Move struct {
+	PlayerID string `json:"playerId"`
+	Position int    `json:"position"`
+}
+
+// ReplayGame rebuilds a game state from its settings, the players in join
+// order and the moves they made, validating every move against the game rules
+func ReplayGame(gameID string, settings rules.Settings, players []string, moves []Move) (*GameState, error) {
+	if len(players) == 0 {
+		return nil, fmt.Errorf("game %s has no players", gameID)
+	}
+
+	game := newGameState(settings, players)
+	for i, move := range moves {
+		if err := applyMove(gameID, game, move.PlayerID, move.Position); err != nil {
+			return nil, fmt.Errorf("invalid move %d in game %s: %w", i+1, gameID, err)
+		}
+	}
+
+	return game, nil
+}
+
+// newGameState returns a fresh game for players listed in join order; the
+// first player is X and moves first, the second is O
+func newGameState(settings rules.Settings, players []string) *GameState {
+	position := rules.NewPosition(settings)
+	game := &GameState{
+		Settings: settings,
+		Board:    position.Board(),
+		Players:  make(map[string]string),
+		Details:  position.Details(),
+		position: position,
+		Turn:     players[0],
+	}
+
+	for seat, playerID := range players {
+		game.Players[playerID] = SeatSymbol(seat)
+	}
+	game.GameReady = len(players) > 1
+
+	return game
+}
+
+// SeatSymbol returns the symbol of the player who joined a game at the given
+// zero-based seat
+func SeatSymbol(seat int) string {
+	if seat == 0 {
+		return rules.X
+	}
+	return rules.O
+}
+
+// applyMove validates a move against the game rules and applies it to the game,
+// updating the winner, game over flag and turn
+func applyMove(gameID string, game *GameState, playerID string, position int) error {
 	if !game.GameReady {
 		log.Warn().
 			Str("game_id", gameID).
@@ -242,26 +699,94 @@ func (m *Manager) MakeMove(gameID string, playerID string, position int) error {
 		return &GameError{Code: ErrNotPlayersTurn, Message: "Not your turn"}
 	}
 
-	if position < 0 || position > 8 {
+	next, err := game.position.Play(position)
+	switch {
+	case errors.Is(err, rules.ErrInvalidCell):
 		log.Warn().
 			Str("game_id", gameID).
 			Str("player_id", playerID).
 			Int("position", position).
 			Msg("Invalid board position")
 		return &GameError{Code: ErrInvalidMove, Message: "Invalid position"}
-	}
-
-	if game.Board[position] != "" {
+	case errors.Is(err, rules.ErrWrongBoard):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("position", position).
+			Interface("details", game.Details).
+			Msg("Move played outside the active sub-board")
+		return &GameError{Code: ErrWrongSubBoard, Message: "Move must be played on the active sub-board"}
+	case errors.Is(err, rules.ErrBoardClosed):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("position", position).
+			Msg("Move played on a decided sub-board")
+		return &GameError{Code: ErrSubBoardClosed, Message: "Sub-board is already decided"}
+	case errors.Is(err, rules.ErrInvalidColumn):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("column", position).
+			Msg("Invalid board column")
+		return &GameError{Code: ErrInvalidColumn, Message: "Invalid column"}
+	case errors.Is(err, rules.ErrColumnFull):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("column", position).
+			Msg("Column already full")
+		return &GameError{Code: ErrColumnFull, Message: "Column is full"}
+	case errors.Is(err, rules.ErrSpookyPair):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("move", position).
+			Msg("Spooky marks placed on a single cell")
+		return &GameError{Code: ErrSpookyPair, Message: "Spooky marks go in two different cells"}
+	case errors.Is(err, rules.ErrCollapseFirst):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("move", position).
+			Interface("details", game.Details).
+			Msg("Spooky marks placed before collapsing a cycle")
+		return &GameError{Code: ErrCollapseRequired, Message: "The cycle must be collapsed first"}
+	case errors.Is(err, rules.ErrNoCycle):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("move", position).
+			Msg("Collapse chosen without a cycle")
+		return &GameError{Code: ErrNoCollapse, Message: "There is no cycle to collapse"}
+	case errors.Is(err, rules.ErrNotInCycle):
+		log.Warn().
+			Str("game_id", gameID).
+			Str("player_id", playerID).
+			Int("move", position).
+			Interface("details", game.Details).
+			Msg("Collapse chosen outside the closing mark")
+		return &GameError{Code: ErrInvalidCollapse, Message: "Collapse must pick a cell of the mark that closed the cycle"}
+	case errors.Is(err, rules.ErrCellOccupied):
 		log.Warn().
 			Str("game_id", gameID).
 			Str("player_id", playerID).
 			Int("position", position).
-			Str("existing_symbol", game.Board[position]).
 			Msg("Position already occupied")
 		return &GameError{Code: ErrPositionOccupied, Message: "Position already occupied"}
+	case err != nil:
+		return &GameError{Code: ErrInvalidMove, Message: err.Error()}
 	}
 
-	game.Board[position] = game.Players[playerID]
+	game.position = next
+	game.Board = next.Board()
+	game.Details = next.Details()
+	game.Moves++
+
+	// Moving instead of answering a draw offer declines it
+	if game.DrawOffer != playerID {
+		game.DrawOffer = ""
+	}
 
 	log.Debug().
 		Str("game_id", gameID).
@@ -270,63 +795,79 @@ func (m *Manager) MakeMove(gameID string, playerID string, position int) error {
 		Interface("board", game.Board).
 		Msg("Move completed")
 
-	// Check for winner
-	if winner := m.checkWinner(game.Board); winner != "" {
-		game.Winner = playerID
+	switch outcome := next.Outcome(); outcome {
+	case rules.XWins, rules.OWins:
+		// The mover is not always the winner, e.g. completing a line in misère loses
+		game.Winner = game.playerWith(outcome.Winner())
 		game.GameOver = true
+		game.Reason = db.GameReasonPlayedOut
+		game.DrawOffer = ""
 		log.Info().
 			Str("game_id", gameID).
-			Str("winner", playerID).
+			Str("winner", game.Winner).
 			Interface("final_board", game.Board).
 			Msg("Game won")
-	} else if m.isBoardFull(game.Board) {
+	case rules.Draw:
 		game.GameOver = true
+		game.Reason = db.GameReasonPlayedOut
+		game.DrawOffer = ""
 		log.Info().
 			Str("game_id", gameID).
 			Interface("final_board", game.Board).
 			Msg("Game ended in draw")
-	} else {
+	default:
 		// Switch turns
-		for pid := range game.Players {
-			if pid != playerID {
-				game.Turn = pid
-				log.Debug().
-					Str("game_id", gameID).
-					Str("next_turn", pid).
-					Msg("Turn switched to next player")
-				break
-			}
-		}
+		game.Turn = game.playerWith(next.ToMove())
+		log.Debug().
+			Str("game_id", gameID).
+			Str("next_turn", game.Turn).
+			Msg("Turn switched to next player")
 	}
 
 	return nil
 }
 
-// checkWinner determines if there's a winner
-func (m *Manager) checkWinner(board [9]string) string {
-	// Winning combinations
-	lines := [][3]int{
-		{0, 1, 2}, {3, 4, 5}, {6, 7, 8}, // Rows
-		{0, 3, 6}, {1, 4, 7}, {2, 5, 8}, // Columns
-		{0, 4, 8}, {2, 4, 6}, // Diagonals
+// playerWith returns the player who plays symbol
+func (g *GameState) playerWith(symbol string) string {
+	for playerID, playerSymbol := range g.Players {
+		if playerSymbol == symbol {
+			return playerID
+		}
 	}
+	return ""
+}
 
-	for _, line := range lines {
-		if board[line[0]] != "" &&
-			board[line[0]] == board[line[1]] &&
-			board[line[1]] == board[line[2]] {
-			return board[line[0]]
+// placedSymbol returns the symbol a move placed, found as the cell that
+// differs between the boards before and after it
+func placedSymbol(before rules.Board, after rules.Board) string {
+	for cell := range after {
+		if before[cell] != after[cell] {
+			return after[cell]
 		}
 	}
-	return ""
+	return rules.Empty
+}
+
+// movedSymbol returns the symbol recorded for the move from before to after:
+// the symbol it placed or, in quantum games, where a move may place no
+// classical mark or several, the symbol of the side that moved
+func movedSymbol(before rules.Position, after rules.Position) string {
+	if before.Settings().IsQuantum() {
+		return before.ToMove()
+	}
+	return placedSymbol(before.Board(), after.Board())
 }
 
-// isBoardFull checks if the board is full (draw)
-func (m *Manager) isBoardFull(board [9]string) bool {
+// encodeBoard serializes a board into the games.current_state format,
+// one character per cell with a space for empty cells (e.g. "XOX O O  ")
+func encodeBoard(board rules.Board) string {
+	var sb strings.Builder
 	for _, cell := range board {
 		if cell == "" {
-			return false
+			sb.WriteByte(' ')
+		} else {
+			sb.WriteString(cell)
 		}
 	}
-	return true
+	return sb.String()
 }
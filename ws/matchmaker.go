@@ -0,0 +1,308 @@
+package ws
+
+import (
+	"context"
+	"main/rules"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// matchmakingInterval is how often the queue is swept for new pairs and expired tickets
+	matchmakingInterval = time.Second
+	// initialRatingWindow is the rating difference a player accepts right after queueing
+	initialRatingWindow = 100.0
+	// ratingWindowGrowth widens the acceptable rating difference per second waited
+	ratingWindowGrowth = 10.0
+	// maxRatingWindow caps the acceptable rating difference
+	maxRatingWindow = 500.0
+)
+
+// MatchResult is delivered once a queued player is paired or leaves the queue
+type MatchResult struct {
+	GameID   string
+	Opponent string
+	Err      error
+}
+
+// Ticket is a player's place in the matchmaking queue
+type Ticket struct {
+	PlayerID   string
+	Rating     float64
+	EnqueuedAt time.Time
+	result     chan MatchResult
+}
+
+// Result returns the channel the ticket's single match result is delivered on
+func (t *Ticket) Result() <-chan MatchResult {
+	return t.result
+}
+
+// ratingWindow returns the largest rating difference the ticket accepts after
+// waiting until now
+func (t *Ticket) ratingWindow(now time.Time) float64 {
+	window := initialRatingWindow + ratingWindowGrowth*now.Sub(t.EnqueuedAt).Seconds()
+	return math.Min(window, maxRatingWindow)
+}
+
+// Matchmaker pairs queued players and starts games between them through the Manager
+type Matchmaker struct {
+	manager *Manager
+	timeout time.Duration
+	queue   []*Ticket
+	mutex   sync.Mutex
+}
+
+// NewMatchmaker creates a matchmaker whose tickets expire after timeout
+func NewMatchmaker(manager *Manager, timeout time.Duration) *Matchmaker {
+	return &Matchmaker{
+		manager: manager,
+		timeout: timeout,
+	}
+}
+
+// Start periodically pairs players whose rating windows have widened enough
+// and expires tickets that waited longer than the queue timeout, until ctx is done
+func (mm *Matchmaker) Start(ctx context.Context) {
+	ticker := time.NewTicker(matchmakingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			mm.mutex.Lock()
+			pairs := mm.pairTickets(now)
+			mm.expireTickets(now)
+			mm.mutex.Unlock()
+
+			mm.startMatches(context.Background(), pairs)
+		}
+	}
+}
+
+// FindMatch queues a player and pairs them with the closest rated opponent
+// within range, if any. The outcome is delivered on the returned ticket.
+func (mm *Matchmaker) FindMatch(ctx context.Context, playerID string) (*Ticket, error) {
+	user, err := mm.manager.store.GetUser(ctx, playerID)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("player_id", playerID).
+			Msg("Failed to load player rating")
+		return nil, &GameError{Code: ErrInternal, Message: "Failed to load player"}
+	}
+
+	mm.mutex.Lock()
+	if mm.indexOf(playerID) >= 0 {
+		mm.mutex.Unlock()
+		log.Warn().
+			Str("player_id", playerID).
+			Msg("Player is already in the matchmaking queue")
+		return nil, &GameError{Code: ErrAlreadyQueued, Message: "Already searching for a match"}
+	}
+
+	ticket := &Ticket{
+		PlayerID:   playerID,
+		Rating:     user.Rating,
+		EnqueuedAt: time.Now(),
+		result:     make(chan MatchResult, 1),
+	}
+	mm.queue = append(mm.queue, ticket)
+	pairs := mm.pairTickets(time.Now())
+	mm.mutex.Unlock()
+
+	log.Info().
+		Str("player_id", playerID).
+		Float64("rating", ticket.Rating).
+		Msg("Player queued for matchmaking")
+
+	// Games outlive the request that completed the pair
+	mm.startMatches(context.WithoutCancel(ctx), pairs)
+	return ticket, nil
+}
+
+// CancelMatch removes a player from the queue
+func (mm *Matchmaker) CancelMatch(playerID string) error {
+	mm.mutex.Lock()
+	defer mm.mutex.Unlock()
+
+	i := mm.indexOf(playerID)
+	if i < 0 {
+		return &GameError{Code: ErrNotQueued, Message: "Not searching for a match"}
+	}
+
+	ticket := mm.queue[i]
+	mm.queue = append(mm.queue[:i], mm.queue[i+1:]...)
+	ticket.result <- MatchResult{Err: &GameError{Code: ErrMatchCancelled, Message: "Matchmaking cancelled"}}
+
+	log.Info().
+		Str("player_id", playerID).
+		Msg("Player left the matchmaking queue")
+
+	return nil
+}
+
+// pairTickets removes and returns pairs of queued players whose ratings are
+// close enough. Players are considered in the order they queued and matched
+// with the closest rated compatible opponent; the pair's host is whoever
+// waited longest. Callers hold mm.mutex.
+func (mm *Matchmaker) pairTickets(now time.Time) [][2]*Ticket {
+	var pairs [][2]*Ticket
+	matched := make(map[*Ticket]bool)
+
+	for i, ticket := range mm.queue {
+		if matched[ticket] {
+			continue
+		}
+
+		var best *Ticket
+		bestDiff := math.Inf(1)
+		for _, candidate := range mm.queue[i+1:] {
+			if matched[candidate] {
+				continue
+			}
+
+			// The longer waiting player's wider window decides
+			diff := math.Abs(ticket.Rating - candidate.Rating)
+			window := math.Max(ticket.ratingWindow(now), candidate.ratingWindow(now))
+			if diff <= window && diff < bestDiff {
+				best, bestDiff = candidate, diff
+			}
+		}
+
+		if best != nil {
+			matched[ticket], matched[best] = true, true
+			pairs = append(pairs, [2]*Ticket{ticket, best})
+		}
+	}
+
+	if len(pairs) > 0 {
+		remaining := mm.queue[:0]
+		for _, ticket := range mm.queue {
+			if !matched[ticket] {
+				remaining = append(remaining, ticket)
+			}
+		}
+		mm.queue = remaining
+	}
+
+	return pairs
+}
+
+// startMatches starts a game for every pair
+func (mm *Matchmaker) startMatches(ctx context.Context, pairs [][2]*Ticket) {
+	for _, pair := range pairs {
+		mm.startMatch(ctx, pair[0], pair[1])
+	}
+}
+
+// startMatch creates a game hosted by the player who waited longest and
+// delivers the result to both tickets
+func (mm *Matchmaker) startMatch(ctx context.Context, host *Ticket, guest *Ticket) {
+	gameID := uuid.NewString()
+
+	err := mm.manager.CreateGame(ctx, gameID, host.PlayerID, rules.Standard, GameOptions{Rated: true})
+	if err == nil {
+		err = mm.manager.JoinGame(ctx, gameID, guest.PlayerID)
+		if err != nil {
+			// Nobody is told about the game, so it must not linger in the lobby
+			mm.abandon(ctx, gameID)
+		}
+	}
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("host_id", host.PlayerID).
+			Str("guest_id", guest.PlayerID).
+			Msg("Failed to start matched game")
+		host.result <- MatchResult{Err: err}
+		guest.result <- MatchResult{Err: err}
+		return
+	}
+
+	log.Info().
+		Str("game_id", gameID).
+		Str("host_id", host.PlayerID).
+		Str("guest_id", guest.PlayerID).
+		Float64("host_rating", host.Rating).
+		Float64("guest_rating", guest.Rating).
+		Dur("host_wait", time.Since(host.EnqueuedAt)).
+		Msg("Players matched")
+
+	host.result <- MatchResult{GameID: gameID, Opponent: guest.PlayerID}
+	guest.result <- MatchResult{GameID: gameID, Opponent: host.PlayerID}
+}
+
+// LeaveMatch takes a player who stopped waiting for their ticket out of
+// matchmaking. If the ticket was already paired, the game started for it is
+// abandoned so the opponent is not left waiting for moves that never come.
+func (mm *Matchmaker) LeaveMatch(ctx context.Context, ticket *Ticket) {
+	mm.mutex.Lock()
+	i := slices.Index(mm.queue, ticket)
+	if i >= 0 {
+		mm.queue = slices.Delete(mm.queue, i, i+1)
+	}
+	mm.mutex.Unlock()
+
+	if i >= 0 {
+		log.Info().
+			Str("player_id", ticket.PlayerID).
+			Msg("Player left the matchmaking queue")
+		return
+	}
+
+	// A ticket out of the queue was paired or expired, and always gets its
+	// result once the game has started or failed to
+	result := <-ticket.result
+	if result.Err == nil {
+		mm.abandon(ctx, result.GameID)
+		mm.manager.BroadcastGameState(result.GameID)
+	}
+}
+
+// abandon ends a matched game that will not be played
+func (mm *Matchmaker) abandon(ctx context.Context, gameID string) {
+	if err := mm.manager.AbandonGame(ctx, gameID); err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Msg("Failed to abandon matched game")
+	}
+}
+
+// expireTickets drops tickets that waited longer than the queue timeout;
+// callers hold mm.mutex
+func (mm *Matchmaker) expireTickets(now time.Time) {
+	remaining := mm.queue[:0]
+	for _, ticket := range mm.queue {
+		if now.Sub(ticket.EnqueuedAt) < mm.timeout {
+			remaining = append(remaining, ticket)
+			continue
+		}
+
+		log.Info().
+			Str("player_id", ticket.PlayerID).
+			Dur("waited", now.Sub(ticket.EnqueuedAt)).
+			Msg("Matchmaking ticket expired")
+		ticket.result <- MatchResult{Err: &GameError{Code: ErrMatchTimeout, Message: "No opponent found"}}
+	}
+	mm.queue = remaining
+}
+
+// indexOf returns the queue position of a player's ticket, or -1; callers hold mm.mutex
+func (mm *Matchmaker) indexOf(playerID string) int {
+	for i, ticket := range mm.queue {
+		if ticket.PlayerID == playerID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,266 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "main/db/sqlc"
+	"main/notation"
+	"main/rules"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// ExportGame writes a finished game in notation
+func (m *Manager) ExportGame(ctx context.Context, gameID string) (string, error) {
+	replay, err := m.LoadReplay(ctx, gameID)
+	if err != nil {
+		return "", err
+	}
+
+	game := &notation.Game{
+		GameID:     replay.GameID,
+		Settings:   replay.Settings,
+		CreatedAt:  replay.CreatedAt,
+		FinishedAt: replay.FinishedAt,
+		Moves:      make([]notation.Move, len(replay.Steps)),
+	}
+	for playerID, symbol := range replay.Players {
+		if symbol == SeatSymbol(0) {
+			game.PlayerX = playerID
+		} else {
+			game.PlayerO = playerID
+		}
+	}
+	for i, step := range replay.Steps {
+		game.Moves[i] = notation.Move{Position: step.Position, PlayedAt: step.PlayedAt}
+	}
+
+	switch {
+	case replay.Winner == "":
+		game.Result = notation.ResultDraw
+	case replay.Winner == game.PlayerX:
+		game.Result = notation.ResultXWins
+	default:
+		game.Result = notation.ResultOWins
+	}
+
+	switch replay.Reason {
+	case db.GameReasonResignation:
+		game.Termination = notation.TerminationResignation
+	case db.GameReasonDrawAgreed:
+		game.Termination = notation.TerminationAgreement
+	}
+
+	return notation.Format(game), nil
+}
+
+// ImportGames validates finished games written in notation against the game
+// rules and stores them, returning their game IDs. Either every game is
+// imported or none is.
+func (m *Manager) ImportGames(ctx context.Context, games []*notation.Game) ([]string, error) {
+	imported := make([]db.ImportedGame, len(games))
+	gameIDs := make([]string, len(games))
+	seen := make(map[string]bool)
+
+	for i, game := range games {
+		gameID := game.GameID
+		if gameID == "" {
+			gameID = uuid.NewString()
+		}
+		if seen[gameID] {
+			return nil, invalidNotation(i, "duplicate game %s", gameID)
+		}
+		seen[gameID] = true
+
+		state, err := validateNotation(gameID, game)
+		if err != nil {
+			return nil, invalidNotation(i, "%s", err)
+		}
+
+		if err := m.checkImport(ctx, gameID, game); err != nil {
+			return nil, err
+		}
+
+		imported[i] = importedGame(gameID, game, state)
+		gameIDs[i] = gameID
+	}
+
+	if _, err := m.store.ImportGamesTx(ctx, db.ImportGamesTxParams{Games: imported}); err != nil {
+		log.Error().
+			Err(err).
+			Int("games", len(games)).
+			Msg("Failed to import games")
+		return nil, &GameError{Code: ErrInternal, Message: "Failed to save games"}
+	}
+
+	log.Info().
+		Int("games", len(games)).
+		Msg("Imported games from notation")
+
+	return gameIDs, nil
+}
+
+// validateNotation replays a notated game under the game rules and checks
+// that it ends with the result it claims. A game with a Termination tag must
+// stop before the rules decide it; a resignation is lost by the side the
+// result names the loser.
+func validateNotation(gameID string, game *notation.Game) (*GameState, error) {
+	if err := game.Settings.Validate(); err != nil {
+		return nil, err
+	}
+	if game.Result == notation.ResultOngoing {
+		return nil, fmt.Errorf("game is not finished")
+	}
+	if game.PlayerX == game.PlayerO {
+		return nil, fmt.Errorf("X and O must be different players")
+	}
+
+	// Moves usually alternate, but a quantum player who collapses a cycle
+	// moves again, so each move goes to the side the rules have to move
+	players := []string{game.PlayerX, game.PlayerO}
+	moves := make([]Move, len(game.Moves))
+	position := rules.NewPosition(game.Settings)
+	for i, move := range game.Moves {
+		moves[i] = Move{PlayerID: moverOf(position, players), Position: move.Position}
+		if next, err := position.Play(move.Position); err == nil {
+			position = next
+		}
+	}
+
+	state, err := ReplayGame(gameID, game.Settings, players, moves)
+	if err != nil {
+		return nil, err
+	}
+
+	if game.Termination != "" {
+		if err := terminate(state, game); err != nil {
+			return nil, err
+		}
+	}
+
+	var result string
+	switch {
+	case !state.GameOver:
+		result = notation.ResultOngoing
+	case state.Winner == game.PlayerX:
+		result = notation.ResultXWins
+	case state.Winner == game.PlayerO:
+		result = notation.ResultOWins
+	default:
+		result = notation.ResultDraw
+	}
+	if result != game.Result {
+		return nil, fmt.Errorf("moves end in %s but result is %s", result, game.Result)
+	}
+
+	return state, nil
+}
+
+// terminate ends a replayed game that stopped off the board the way its
+// Termination tag says
+func terminate(state *GameState, game *notation.Game) error {
+	if state.GameOver {
+		return fmt.Errorf("moves end the game, so it cannot end by %s", game.Termination)
+	}
+
+	switch {
+	case game.Termination == notation.TerminationAgreement && game.Result == notation.ResultDraw:
+		state.endEarly(db.GameReasonDrawAgreed, "", "")
+	case game.Termination == notation.TerminationResignation && game.Result == notation.ResultXWins:
+		state.endEarly(db.GameReasonResignation, game.PlayerX, game.PlayerO)
+	case game.Termination == notation.TerminationResignation && game.Result == notation.ResultOWins:
+		state.endEarly(db.GameReasonResignation, game.PlayerO, game.PlayerX)
+	default:
+		return fmt.Errorf("termination %s cannot give result %s", game.Termination, game.Result)
+	}
+	return nil
+}
+
+// checkImport makes sure a game ID is free and its players exist
+func (m *Manager) checkImport(ctx context.Context, gameID string, game *notation.Game) error {
+	_, err := m.store.GetGame(ctx, gameID)
+	if err == nil {
+		return &GameError{Code: ErrGameExists, Message: fmt.Sprintf("Game %s already exists", gameID)}
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("cannot get game %s: %w", gameID, err)
+	}
+
+	for _, username := range []string{game.PlayerX, game.PlayerO} {
+		if _, err := m.store.GetUser(ctx, username); err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return &GameError{Code: ErrUserNotFound, Message: fmt.Sprintf("User %s not found", username)}
+			}
+			return fmt.Errorf("cannot get user %s: %w", username, err)
+		}
+	}
+
+	return nil
+}
+
+// importedGame fills in the times missing from a notated game: moves without
+// a time inherit the previous one, starting from the creation time
+func importedGame(gameID string, game *notation.Game, state *GameState) db.ImportedGame {
+	createdAt := game.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	imported := db.ImportedGame{
+		Code:             gameID,
+		Usernames:        []string{game.PlayerX, game.PlayerO},
+		WinnerUsername:   state.Winner,
+		ResultReason:     state.Reason,
+		ResignedUsername: state.ResignedBy,
+		CurrentState:     encodeBoard(state.Board),
+		Variant:          game.Settings.Variant,
+		Width:            int32(game.Settings.Width),
+		Height:           int32(game.Settings.Height),
+		WinLength:        int32(game.Settings.WinLength),
+		Depth:            int32(game.Settings.Depth),
+		CreatedAt:        createdAt,
+		Moves:            make([]db.ImportedMove, len(game.Moves)),
+	}
+
+	playedAt := createdAt
+	position := rules.NewPosition(game.Settings)
+	for i, move := range game.Moves {
+		if !move.PlayedAt.IsZero() {
+			playedAt = move.PlayedAt
+		}
+		// The moves were validated, so replaying them cannot fail
+		next, _ := position.Play(move.Position)
+		imported.Moves[i] = db.ImportedMove{
+			Username: moverOf(position, imported.Usernames),
+			Position: int32(move.Position),
+			Symbol:   movedSymbol(position, next),
+			PlayedAt: playedAt,
+		}
+		position = next
+	}
+
+	imported.FinishedAt = game.FinishedAt
+	if imported.FinishedAt.IsZero() {
+		imported.FinishedAt = playedAt
+	}
+
+	return imported
+}
+
+// moverOf returns which of the players, X first, moves next in position
+func moverOf(position rules.Position, players []string) string {
+	if position.ToMove() == rules.O {
+		return players[1]
+	}
+	return players[0]
+}
+
+func invalidNotation(index int, format string, args ...interface{}) error {
+	return &GameError{
+		Code:    ErrInvalidNotation,
+		Message: fmt.Sprintf("Game %d: %s", index+1, fmt.Sprintf(format, args...)),
+	}
+}
@@ -0,0 +1,152 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	db "main/db/sqlc"
+	"main/rules"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+// Playback speed limits accepted by the replay message
+const (
+	minReplaySpeed = 0.25
+	maxReplaySpeed = 10
+)
+
+// ReplayStep is a single ply of a finished game together with the board it produced
+type ReplayStep struct {
+	MoveNumber int         `json:"moveNumber"`
+	PlayerID   string      `json:"playerId"`
+	Symbol     string      `json:"symbol"`
+	Position   int         `json:"position"`
+	Board      rules.Board `json:"board"`
+	PlayedAt   time.Time   `json:"playedAt"`
+}
+
+// GameReplay is the complete move sequence of a finished game
+type GameReplay struct {
+	GameID     string            `json:"gameId"`
+	Settings   rules.Settings    `json:"settings"`
+	Players    map[string]string `json:"players"` // map[playerID]symbol (X or O)
+	Winner     string            `json:"winner"`
+	Reason     string            `json:"reason"` // how the game ended, see GameState
+	CreatedAt  time.Time         `json:"createdAt"`
+	FinishedAt time.Time         `json:"finishedAt"`
+	Steps      []ReplayStep      `json:"steps"`
+}
+
+// LoadReplay rebuilds a finished game ply by ply from its move log
+func (m *Manager) LoadReplay(ctx context.Context, gameID string) (*GameReplay, error) {
+	game, err := m.store.GetGame(ctx, gameID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, &GameError{Code: ErrGameNotFound, Message: "Game not found"}
+		}
+		return nil, fmt.Errorf("cannot get game %s: %w", gameID, err)
+	}
+
+	if game.Status != db.GameStatusCompleted && game.Status != db.GameStatusImported {
+		return nil, &GameError{Code: ErrGameNotFinished, Message: "Game is not finished"}
+	}
+
+	players, err := m.store.ListGameParticipantUsernames(ctx, pgtype.Int8{Int64: game.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list participants of game %s: %w", gameID, err)
+	}
+	if len(players) == 0 {
+		return nil, fmt.Errorf("game %s has no players", gameID)
+	}
+
+	rows, err := m.store.ListGameMoves(ctx, game.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list moves of game %s: %w", gameID, err)
+	}
+
+	state := newGameState(settingsOf(game), players)
+	replay := &GameReplay{
+		GameID:     gameID,
+		Settings:   state.Settings,
+		Players:    state.Players,
+		CreatedAt:  game.CreatedAt,
+		FinishedAt: game.FinishedAt.Time,
+		Steps:      make([]ReplayStep, len(rows)),
+	}
+
+	for i, row := range rows {
+		if err := applyMove(gameID, state, row.Username, int(row.Position)); err != nil {
+			return nil, fmt.Errorf("invalid move %d in game %s: %w", row.MoveNumber, gameID, err)
+		}
+
+		replay.Steps[i] = ReplayStep{
+			MoveNumber: int(row.MoveNumber),
+			PlayerID:   row.Username,
+			Symbol:     row.Symbol,
+			Position:   int(row.Position),
+			Board:      state.Board,
+			PlayedAt:   row.CreatedAt,
+		}
+	}
+	if err := m.restoreResult(ctx, game, state); err != nil {
+		return nil, err
+	}
+	replay.Winner = state.Winner
+	replay.Reason = state.Reason
+
+	return replay, nil
+}
+
+// streamReplay sends the plies of a replay to a client one at a time, waiting
+// interval between plies, until the replay ends or ctx is cancelled
+func streamReplay(ctx context.Context, client *Client, replay *GameReplay, interval time.Duration) {
+	client.WriteJSON(&Message{
+		Type:   "replay_started",
+		GameID: replay.GameID,
+		Data: map[string]interface{}{
+			"players":    replay.Players,
+			"totalMoves": len(replay.Steps),
+		},
+	})
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for _, step := range replay.Steps {
+		select {
+		case <-ctx.Done():
+			log.Info().
+				Str("client_id", client.ID).
+				Str("game_id", replay.GameID).
+				Int("move_number", step.MoveNumber).
+				Msg("Replay stopped")
+			return
+		case <-ticker.C:
+		}
+
+		if err := client.WriteJSON(&Message{
+			Type:   "replay_move",
+			GameID: replay.GameID,
+			Data:   step,
+		}); err != nil {
+			log.Error().
+				Err(err).
+				Str("client_id", client.ID).
+				Str("game_id", replay.GameID).
+				Msg("Failed to send replay move")
+			return
+		}
+	}
+
+	client.WriteJSON(&Message{
+		Type:   "replay_finished",
+		GameID: replay.GameID,
+		Data: map[string]string{
+			"winner": replay.Winner,
+			"reason": replay.Reason,
+		},
+	})
+}
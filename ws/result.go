@@ -0,0 +1,251 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"main/bot"
+	db "main/db/sqlc"
+	"main/rules"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Resign ends a game in a loss for playerID
+func (m *Manager) Resign(ctx context.Context, gameID string, playerID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	game, err := m.playingGame(gameID, playerID)
+	if err != nil {
+		return err
+	}
+
+	next := *game
+	next.endEarly(db.GameReasonResignation, game.opponentOf(playerID), playerID)
+	if err := m.storeResult(ctx, gameID, &next); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("game_id", gameID).
+		Str("player_id", playerID).
+		Str("winner", next.Winner).
+		Msg("Player resigned")
+
+	*game = next
+	return nil
+}
+
+// OfferDraw offers playerID's opponent a draw. The offer stands until the
+// opponent answers it or moves; offering a draw the opponent has already
+// offered accepts it. Bots answer offers after their thinking delay.
+func (m *Manager) OfferDraw(ctx context.Context, gameID string, playerID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	game, err := m.playingGame(gameID, playerID)
+	if err != nil {
+		return err
+	}
+
+	switch game.DrawOffer {
+	case playerID:
+		return &GameError{Code: ErrDrawOffered, Message: "You have already offered a draw"}
+	case game.opponentOf(playerID):
+		return m.agreeDraw(ctx, gameID, game)
+	}
+
+	game.DrawOffer = playerID
+	log.Info().
+		Str("game_id", gameID).
+		Str("player_id", playerID).
+		Msg("Draw offered")
+
+	opponent := game.opponentOf(playerID)
+	if _, ok := bot.LevelOf(opponent); ok {
+		time.AfterFunc(m.botDelay, func() {
+			m.answerDrawOffer(gameID, opponent)
+		})
+	}
+	return nil
+}
+
+// AcceptDraw accepts the draw offered to playerID, ending the game in a draw
+func (m *Manager) AcceptDraw(ctx context.Context, gameID string, playerID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	game, err := m.playingGame(gameID, playerID)
+	if err != nil {
+		return err
+	}
+	if game.DrawOffer != game.opponentOf(playerID) {
+		return &GameError{Code: ErrNoDrawOffer, Message: "No draw has been offered to you"}
+	}
+
+	return m.agreeDraw(ctx, gameID, game)
+}
+
+// DeclineDraw turns down the draw offered to playerID, or withdraws the
+// player's own offer, and play goes on
+func (m *Manager) DeclineDraw(ctx context.Context, gameID string, playerID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	game, err := m.playingGame(gameID, playerID)
+	if err != nil {
+		return err
+	}
+	if game.DrawOffer == "" {
+		return &GameError{Code: ErrNoDrawOffer, Message: "No draw has been offered"}
+	}
+
+	log.Info().
+		Str("game_id", gameID).
+		Str("player_id", playerID).
+		Str("offered_by", game.DrawOffer).
+		Msg("Draw declined")
+
+	game.DrawOffer = ""
+	return nil
+}
+
+// AbandonGame ends a game that will not be played without a result, e.g. one
+// whose player stopped waiting for it, leaving ratings untouched
+func (m *Manager) AbandonGame(ctx context.Context, gameID string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	game, exists := m.games[gameID]
+	if !exists {
+		return &GameError{Code: ErrGameNotFound, Message: "Game not found"}
+	}
+	if game.GameOver {
+		return &GameError{Code: ErrGameNotReady, Message: "Game is already over"}
+	}
+
+	if _, err := m.store.AbandonGame(ctx, gameID); err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Msg("Failed to persist abandoned game")
+		return &GameError{Code: ErrInternal, Message: "Failed to save game"}
+	}
+
+	log.Info().
+		Str("game_id", gameID).
+		Msg("Game abandoned")
+
+	game.endEarly(db.GameReasonAbandoned, "", "")
+	return nil
+}
+
+// agreeDraw ends game in a draw both players agreed to. The caller must hold
+// the manager's lock.
+func (m *Manager) agreeDraw(ctx context.Context, gameID string, game *GameState) error {
+	next := *game
+	next.endEarly(db.GameReasonDrawAgreed, "", "")
+	if err := m.storeResult(ctx, gameID, &next); err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("game_id", gameID).
+		Msg("Game drawn by agreement")
+
+	*game = next
+	return nil
+}
+
+// answerDrawOffer lets a bot accept a draw offer when it sees no win for
+// itself, or decline it, and broadcasts the result
+func (m *Manager) answerDrawOffer(gameID string, botID string) {
+	game, err := m.GetGame(gameID)
+	if err != nil || game.GameOver || game.DrawOffer == "" || game.DrawOffer == botID {
+		return
+	}
+
+	// Think without holding the lock; the answer checks the offer again
+	answer := m.DeclineDraw
+	if bot.AcceptsDraw(game.position, game.Players[botID]) {
+		answer = m.AcceptDraw
+	}
+	if err := answer(context.Background(), gameID, botID); err != nil {
+		log.Warn().
+			Err(err).
+			Str("game_id", gameID).
+			Str("bot", botID).
+			Msg("Bot could not answer draw offer")
+		return
+	}
+
+	m.BroadcastGameState(gameID)
+}
+
+// playingGame returns a game in progress that playerID plays in. The caller
+// must hold the manager's lock.
+func (m *Manager) playingGame(gameID string, playerID string) (*GameState, error) {
+	game, exists := m.games[gameID]
+	switch {
+	case !exists:
+		return nil, &GameError{Code: ErrGameNotFound, Message: "Game not found"}
+	case game.Players[playerID] == "":
+		return nil, &GameError{Code: ErrNotPlayer, Message: "You are not playing in this game"}
+	case !game.GameReady:
+		return nil, &GameError{Code: ErrGameNotReady, Message: "Game is not ready to start"}
+	case game.GameOver:
+		return nil, &GameError{Code: ErrGameNotReady, Message: "Game is already over"}
+	}
+	return game, nil
+}
+
+// storeResult persists the end of a game that finished off the board
+func (m *Manager) storeResult(ctx context.Context, gameID string, game *GameState) error {
+	_, err := m.store.EndGameTx(ctx, db.EndGameTxParams{
+		Code:             gameID,
+		Reason:           game.Reason,
+		WinnerUsername:   game.Winner,
+		ResignedUsername: game.ResignedBy,
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("game_id", gameID).
+			Str("reason", game.Reason).
+			Msg("Failed to persist game result")
+		return &GameError{Code: ErrInternal, Message: "Failed to save game"}
+	}
+	return nil
+}
+
+// restoreResult ends a stored game that finished off the board the way it
+// ended, since its move log alone leaves it unfinished
+func (m *Manager) restoreResult(ctx context.Context, game db.Game, state *GameState) error {
+	reason := game.ResultReason.String
+	if !game.ResultReason.Valid || reason == db.GameReasonPlayedOut {
+		return nil
+	}
+
+	result, err := m.store.GetGameResult(ctx, game.ID)
+	if err != nil {
+		return fmt.Errorf("cannot get result of game %s: %w", game.Code, err)
+	}
+	state.endEarly(reason, result.WinnerUsername.String, result.ResignedUsername.String)
+	return nil
+}
+
+// endEarly finishes a game before the rules decide it, by resignation, an
+// agreed draw or abandonment; winner and resignedBy are empty without a winner
+func (g *GameState) endEarly(reason string, winner string, resignedBy string) {
+	g.GameOver = true
+	g.Winner = winner
+	g.Reason = reason
+	g.ResignedBy = resignedBy
+	g.DrawOffer = ""
+}
+
+// opponentOf returns the player facing playerID
+func (g *GameState) opponentOf(playerID string) string {
+	return g.playerWith(rules.Opponent(g.Players[playerID]))
+}
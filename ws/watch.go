@@ -0,0 +1,65 @@
+package ws
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// watchBufferSize is how many undelivered messages a watcher may fall behind
+// before further messages are dropped for it
+const watchBufferSize = 16
+
+// Watch subscribes to every message broadcast for a game, the same messages
+// WebSocket clients in the game receive. The returned function stops watching
+// and closes the channel.
+func (m *Manager) Watch(gameID string) (<-chan *Message, func()) {
+	ch := make(chan *Message, watchBufferSize)
+
+	m.watchMutex.Lock()
+	if m.watchers[gameID] == nil {
+		m.watchers[gameID] = make(map[chan *Message]struct{})
+	}
+	m.watchers[gameID][ch] = struct{}{}
+	m.watchMutex.Unlock()
+
+	log.Debug().
+		Str("game_id", gameID).
+		Msg("Watcher subscribed to game")
+
+	stop := func() {
+		m.watchMutex.Lock()
+		defer m.watchMutex.Unlock()
+
+		if _, ok := m.watchers[gameID][ch]; !ok {
+			return
+		}
+		delete(m.watchers[gameID], ch)
+		if len(m.watchers[gameID]) == 0 {
+			delete(m.watchers, gameID)
+		}
+		close(ch)
+
+		log.Debug().
+			Str("game_id", gameID).
+			Msg("Watcher unsubscribed from game")
+	}
+
+	return ch, stop
+}
+
+// notifyWatchers forwards a broadcast message to the game's watchers without
+// blocking the manager loop on slow consumers
+func (m *Manager) notifyWatchers(message *Message) {
+	m.watchMutex.Lock()
+	defer m.watchMutex.Unlock()
+
+	for ch := range m.watchers[message.GameID] {
+		select {
+		case ch <- message:
+		default:
+			log.Warn().
+				Str("game_id", message.GameID).
+				Str("message_type", message.Type).
+				Msg("Dropping message for slow watcher")
+		}
+	}
+}